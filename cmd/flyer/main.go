@@ -11,6 +11,10 @@ import (
 	"github.com/five82/flyer/internal/app"
 )
 
+// version is the release version, set at build time with
+// -ldflags "-X main.version=v1.2.3". Empty falls back to module build info.
+var version string
+
 func main() {
 	os.Exit(run())
 }
@@ -26,8 +30,11 @@ func flagOrEnv(flagValue, envKey string) string {
 func run() int {
 	configPath := flag.String("config", "", "override spindle config path (optional)")
 	pollSeconds := flag.Int("poll", 0, "refresh interval in seconds (optional, defaults to 2s)")
+	pollMin := flag.Int("poll-min", 0, "refresh interval in seconds while items are running (optional)")
+	pollMax := flag.Int("poll-max", 0, "refresh interval in seconds while idle or offline (optional)")
 	apiEndpoint := flag.String("api", "", "Spindle API endpoint URL (e.g., http://server:7487)")
 	apiToken := flag.String("token", "", "API bearer token for authentication")
+	caCert := flag.String("ca-cert", "", "PEM file of CA certificates to trust for https:// endpoints")
 	flag.Parse()
 
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
@@ -37,6 +44,10 @@ func run() int {
 		ConfigPath:  *configPath,
 		APIEndpoint: flagOrEnv(*apiEndpoint, "FLYER_API_ENDPOINT"),
 		APIToken:    flagOrEnv(*apiToken, "FLYER_API_TOKEN"),
+		CACert:      flagOrEnv(*caCert, "FLYER_CA_CERT"),
+		PollMin:     max(*pollMin, 0),
+		PollMax:     max(*pollMax, 0),
+		Version:     version,
 	}
 	if poll := *pollSeconds; poll > 0 {
 		opts.PollEvery = poll
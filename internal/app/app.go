@@ -3,9 +3,14 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/five82/flyer/internal/config"
+	"github.com/five82/flyer/internal/notify"
+	"github.com/five82/flyer/internal/opener"
 	"github.com/five82/flyer/internal/prefs"
 	"github.com/five82/flyer/internal/spindle"
 	"github.com/five82/flyer/internal/state"
@@ -17,8 +22,12 @@ type Options struct {
 	ConfigPath  string
 	PrefsPath   string // empty uses default ~/.config/flyer/prefs.toml
 	PollEvery   int    // seconds; zero uses default
+	PollMin     int    // seconds between polls while items run; zero disables
+	PollMax     int    // seconds between polls while idle or offline; zero disables
 	APIEndpoint string // override Spindle API endpoint (e.g., http://server:7487)
 	APIToken    string // bearer token for API authentication
+	CACert      string // PEM file of extra CAs trusted for https:// endpoints
+	Version     string // release version from -ldflags; empty uses module build info
 }
 
 // Run boots the Flyer TUI until the context is cancelled.
@@ -29,6 +38,12 @@ func Run(ctx context.Context, opts Options) error {
 	}
 
 	userPrefs := prefs.Load(opts.PrefsPath)
+	themeDir, _ := prefs.ThemesDir(opts.PrefsPath)
+
+	version := opts.Version
+	if version == "" {
+		version = buildVersion()
+	}
 
 	// Explicit CLI/environment values win over local Spindle config.
 	apiEndpoint := opts.APIEndpoint
@@ -40,10 +55,17 @@ func Run(ctx context.Context, opts Options) error {
 		apiToken = cfg.APIToken
 	}
 
-	var clientOpts []spindle.ClientOption
+	clientOpts := []spindle.ClientOption{
+		spindle.WithRetry(clientRetry),
+		spindle.WithETags(),
+		spindle.WithVersion(version),
+	}
 	if apiToken != "" {
 		clientOpts = append(clientOpts, spindle.WithToken(apiToken))
 	}
+	if opts.CACert != "" {
+		clientOpts = append(clientOpts, spindle.WithCACert(opts.CACert))
+	}
 
 	client, err := spindle.NewClient(apiEndpoint, clientOpts...)
 	if err != nil {
@@ -51,6 +73,8 @@ func Run(ctx context.Context, opts Options) error {
 	}
 
 	store := &state.Store{}
+	store.SetEndpoint(client.BaseURL())
+	watchConfig(ctx, opts, client, store)
 
 	interval := defaultPollInterval
 	if opts.PollEvery > 0 {
@@ -58,7 +82,11 @@ func Run(ctx context.Context, opts Options) error {
 	}
 
 	// Start background poller
-	StartPoller(ctx, store, client, interval)
+	StartPoller(ctx, store, client, PollSchedule{
+		Interval: interval,
+		Min:      time.Duration(opts.PollMin) * time.Second,
+		Max:      time.Duration(opts.PollMax) * time.Second,
+	}, problemNotifier(ctx, userPrefs.NotifyProblems))
 
 	// Do initial refresh to populate store before UI starts
 	_ = refresh(ctx, store, client)
@@ -71,7 +99,71 @@ func Run(ctx context.Context, opts Options) error {
 		PollTick:  interval,
 		ThemeName: userPrefs.Theme,
 		PrefsPath: opts.PrefsPath,
+		Prefs:     userPrefs,
+		ThemeDir:  themeDir,
 		Refresh:   func() error { return refresh(ctx, store, client) },
+
+		CheckUpdate: newUpdateCheck(ctx, userPrefs.UpdateCheckURL, version),
+		APIEndpoint: client.BaseURL(),
+		Opener:      opener.System{},
 	}
 	return ui.Run(uiOpts)
 }
+
+// problemNotifier returns the poller's problem callback: a desktop
+// notification per problem when enabled, nil otherwise. Notifications are
+// best-effort; a platform without a notifier stays silent.
+func problemNotifier(ctx context.Context, enabled bool) func(notify.Problem) {
+	if !enabled {
+		return nil
+	}
+	return func(p notify.Problem) {
+		go func() { _ = notify.Send(ctx, p) }()
+	}
+}
+
+// watchConfig keeps the client in step with edits to the Spindle config's
+// [api] section: file changes are picked up by polling and SIGHUP forces
+// an immediate reload. A new bind repoints the client and the store, and
+// the poller starts over against the new daemon; a new token applies from
+// the next request. Explicit --api and --token values are never replaced.
+// A reload that fails keeps the current settings and is reported as the
+// store's ConfigError until a later reload succeeds.
+func watchConfig(ctx context.Context, opts Options, client *spindle.Client, store *state.Store) {
+	if opts.APIEndpoint != "" && opts.APIToken != "" {
+		return
+	}
+	apply := func(cfg config.Config) {
+		if opts.APIToken == "" {
+			client.SetToken(cfg.APIToken)
+		}
+		if opts.APIEndpoint == "" && cfg.APIBind != "" {
+			if err := client.SetBaseURL(cfg.APIBind); err != nil {
+				store.SetConfigError(fmt.Errorf("api.bind: %w", err))
+				return
+			}
+			store.SetEndpoint(client.BaseURL())
+		}
+		store.SetConfigError(nil)
+	}
+	config.Watch(ctx, opts.ConfigPath, apply, store.SetConfigError)
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				cfg, err := config.Load(opts.ConfigPath)
+				if err != nil {
+					store.SetConfigError(err)
+					continue
+				}
+				apply(cfg)
+			}
+		}
+	}()
+}
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
+)
+
+func TestWatchConfig_SIGHUPReloadsEndpointAndToken(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var mu sync.Mutex
+	var auth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		auth = append(auth, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("[api]\nbind = \"127.0.0.1:1\"\ntoken = \"old\"\n")
+
+	client, err := spindle.NewClient("127.0.0.1:1", spindle.WithToken("old"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &state.Store{}
+	store.SetEndpoint(client.BaseURL())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	watchConfig(ctx, Options{ConfigPath: path}, client, store)
+
+	// hup signals a reload and waits until the store satisfies done.
+	hup := func(done func(state.Snapshot) bool) state.Snapshot {
+		t.Helper()
+		if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+			t.Fatal(err)
+		}
+		deadline := time.Now().Add(2 * time.Second)
+		for {
+			snap := store.Snapshot()
+			if done(snap) {
+				return snap
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("reload not applied; snapshot = %+v", snap)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	write(fmt.Sprintf("[api]\nbind = %q\ntoken = \"new\"\n", server.URL))
+	hup(func(s state.Snapshot) bool { return s.Endpoint == server.URL })
+	if _, err := client.FetchQueue(ctx); err != nil {
+		t.Fatalf("FetchQueue after reload: %v", err)
+	}
+	mu.Lock()
+	got := fmt.Sprint(auth)
+	mu.Unlock()
+	if got != "[Bearer new]" {
+		t.Fatalf("Authorization after reload = %s, want the new token", got)
+	}
+
+	write("[api\nbind = ")
+	snap := hup(func(s state.Snapshot) bool { return s.ConfigError != nil })
+	if snap.Endpoint != server.URL || client.BaseURL() != server.URL {
+		t.Fatalf("failed reload changed the endpoint to %q", snap.Endpoint)
+	}
+
+	write(fmt.Sprintf("[api]\nbind = %q\n", server.URL))
+	hup(func(s state.Snapshot) bool { return s.ConfigError == nil })
+}
@@ -2,10 +2,12 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/five82/flyer/internal/notify"
 	"github.com/five82/flyer/internal/spindle"
 	"github.com/five82/flyer/internal/state"
 )
@@ -13,76 +15,253 @@ import (
 const (
 	defaultPollInterval = 2 * time.Second
 	maxBackoff          = 30 * time.Second
+
+	// queueDeltaFullEvery forces a full queue fetch after this many delta
+	// polls, since deltas never report removed items.
+	queueDeltaFullEvery = 30
 )
 
-// StartPoller launches a background goroutine that refreshes the store at a
-// fixed cadence with exponential backoff on failures. It returns immediately.
-func StartPoller(ctx context.Context, store *state.Store, client *spindle.Client, interval time.Duration) {
+// clientRetry retries transient request failures briefly so a daemon
+// restart does not flip the UI offline until the next poll.
+var clientRetry = spindle.RetryOptions{
+	MaxAttempts: 3,
+	BaseDelay:   250 * time.Millisecond,
+	MaxDelay:    time.Second,
+	Jitter:      0.2,
+}
+
+// PollSchedule sets the poll cadence. With neither bound set every poll
+// waits Interval; otherwise polls speed up to Min while any item has a
+// running task and slow down to Max while none does or the daemon is
+// offline. An unset bound falls back to Interval; Min above Max is capped
+// at Max.
+type PollSchedule struct {
+	Interval time.Duration
+	Min      time.Duration
+	Max      time.Duration
+}
+
+// next returns the interval to wait after a poll that left snap in the
+// store.
+func (p PollSchedule) next(snap state.Snapshot) time.Duration {
+	interval := p.Interval
 	if interval <= 0 {
 		interval = defaultPollInterval
 	}
-	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
+	if snap.IsOffline() || !hasRunningItem(snap.Queue) {
+		if p.Max > 0 {
+			return p.Max
+		}
+		return interval
+	}
+	if p.Min > 0 {
+		if p.Max > 0 {
+			return min(p.Min, p.Max)
+		}
+		return p.Min
+	}
+	return interval
+}
 
-		var consecutiveFailures int
-		var lastPollTime time.Time
+// hasRunningItem reports whether any item has a running task.
+func hasRunningItem(queue []spindle.QueueItem) bool {
+	for _, item := range queue {
+		if len(item.RunningTasks()) > 0 {
+			return true
+		}
+	}
+	return false
+}
 
+// StartPoller launches a background goroutine that refreshes the store on
+// the schedule's cadence with exponential backoff on failures. A non-nil
+// onProblem is called for each item that newly fails or needs review. When
+// the store's endpoint changes the poller starts over against the new
+// daemon. It returns immediately.
+func StartPoller(ctx context.Context, store *state.Store, client spindle.StatusFetcher, schedule PollSchedule, onProblem func(notify.Problem)) {
+	p := &poller{store: store, client: client, schedule: schedule, onProblem: onProblem, endpoint: store.Endpoint()}
+	go func() {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
 		for {
-			// Calculate backoff based on consecutive failures
-			backoff := calculateBackoff(consecutiveFailures, interval)
-
-			// Skip poll if within backoff period
-			if consecutiveFailures > 0 && time.Since(lastPollTime) < backoff {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					continue
-				}
-			}
-
-			lastPollTime = time.Now()
-			err := refresh(ctx, store, client)
-			if err != nil {
-				consecutiveFailures++
-			} else {
-				consecutiveFailures = 0
-			}
-
 			select {
 			case <-ctx.Done():
 				return
-			case <-ticker.C:
+			case <-timer.C:
 			}
+			timer.Reset(p.poll(ctx, time.Now()))
 		}
 	}()
 }
 
+// poller is the state StartPoller carries between polls.
+type poller struct {
+	store    *state.Store
+	client   spindle.StatusFetcher
+	schedule PollSchedule
+	cursor   queueCursor
+	endpoint string // store endpoint the cursor and tracker belong to
+
+	onProblem func(notify.Problem)
+	problems  notify.Tracker
+}
+
+// poll refreshes the store once and returns the delay before the next
+// poll: the schedule's interval after a poll that reached the daemon, or a
+// backoff growing with the store's consecutive failures, which is also
+// recorded as the store's RetryAt.
+func (p *poller) poll(ctx context.Context, now time.Time) time.Duration {
+	if endpoint := p.store.Endpoint(); endpoint != p.endpoint {
+		// A different daemon: fetch its whole queue, and prime the
+		// tracker afresh so its existing problems do not alert.
+		p.endpoint = endpoint
+		p.cursor = queueCursor{}
+		p.problems = notify.Tracker{}
+	}
+	err := refreshWith(ctx, p.store, p.client, &p.cursor)
+	snap := p.store.Snapshot()
+	if p.onProblem != nil && snap.ConsecutiveFailures == 0 {
+		for _, problem := range p.problems.Update(snap.Queue) {
+			p.onProblem(problem)
+		}
+	}
+	interval := p.schedule.next(snap)
+	if err == nil || snap.ConsecutiveFailures == 0 {
+		return interval
+	}
+	delay := calculateBackoff(snap.ConsecutiveFailures, interval)
+	p.store.ScheduleRetry(now.Add(delay))
+	return delay
+}
+
 // calculateBackoff returns the backoff duration for the given failure count.
-// Uses exponential backoff: interval * 2^failures, capped at maxBackoff.
+// The first failure retries at the normal interval so a brief hiccup is
+// noticed quickly; each further failure doubles the delay, capped at
+// maxBackoff (or the interval itself, when that is longer).
 func calculateBackoff(failures int, baseInterval time.Duration) time.Duration {
-	if failures <= 0 {
+	if failures <= 1 {
 		return baseInterval
 	}
-	// Exponential backoff: baseInterval * 2^failures
-	backoff := baseInterval * time.Duration(1<<failures)
+	// Exponential backoff: baseInterval * 2^(failures-1)
+	backoff := baseInterval * time.Duration(1<<min(failures-1, 16))
 	if backoff > maxBackoff {
-		return maxBackoff
+		return max(maxBackoff, baseInterval)
 	}
 	return backoff
 }
 
-// refresh fetches status and queue concurrently and applies both to the
-// store atomically: only when both fetches succeed does the store see new
-// data, matching the prior sequential behavior where a failure on either
-// endpoint left the store untouched.
-func refresh(ctx context.Context, store *state.Store, client *spindle.Client) error {
+// queueCursor tracks incremental queue polling across refreshes. The
+// cursor is the daemon's own clock: the newest UpdatedAt after a full
+// fetch, then the ServerTime of each delta.
+type queueCursor struct {
+	mu          sync.Mutex
+	since       time.Time // zero forces a full fetch
+	deltas      int       // delta polls since the last full fetch
+	unsupported bool
+}
+
+// next reports whether this poll should fetch a delta, and from when.
+func (c *queueCursor) next() (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.unsupported || c.since.IsZero() || c.deltas >= queueDeltaFullEvery {
+		return time.Time{}, false
+	}
+	c.deltas++
+	return c.since, true
+}
+
+// advance moves the cursor after a delta.
+func (c *queueCursor) advance(delta spindle.QueueDelta) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !delta.ServerTime.IsZero() {
+		c.since = delta.ServerTime
+		return
+	}
+	if newest := newestUpdate(delta.Items); newest.After(c.since) {
+		c.since = newest
+	}
+}
+
+// reset restarts the cursor after a full fetch; a nil queue (304) keeps
+// the current position.
+func (c *queueCursor) reset(queue []spindle.QueueItem, changed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if changed {
+		c.since = newestUpdate(queue)
+	}
+	c.deltas = 0
+}
+
+// disable falls back to full fetches for the rest of the session.
+func (c *queueCursor) disable() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsupported = true
+}
+
+// newestUpdate returns the latest parseable UpdatedAt among items.
+func newestUpdate(items []spindle.QueueItem) time.Time {
+	var newest time.Time
+	for _, item := range items {
+		if t := item.ParsedUpdatedAt(); t.After(newest) {
+			newest = t
+		}
+	}
+	return newest
+}
+
+// fetchQueue fetches the queue, as a delta when the cursor allows. delta
+// reports that queue holds only the changed items. commit moves the cursor
+// past the fetched queue; call it once the queue is in the store, so the
+// cursor never runs ahead of the items it has applied. commit is nil when
+// there is nothing to record.
+func fetchQueue(ctx context.Context, client spindle.StatusFetcher, cursor *queueCursor) (queue []spindle.QueueItem, delta bool, commit func(), err error) {
+	if cursor != nil {
+		if since, ok := cursor.next(); ok {
+			d, err := client.FetchQueueDelta(ctx, since)
+			switch {
+			case err == nil:
+				return d.Items, true, func() { cursor.advance(d) }, nil
+			case errors.Is(err, spindle.ErrQueueDeltaUnsupported):
+				cursor.disable()
+			default:
+				return nil, true, nil, err
+			}
+		}
+	}
+	queue, err = client.FetchQueue(ctx)
+	if cursor != nil && (err == nil || errors.Is(err, spindle.ErrNotModified)) {
+		changed := err == nil
+		commit = func() { cursor.reset(queue, changed) }
+	}
+	return queue, false, commit, err
+}
+
+// refresh performs a full refresh of status and queue.
+func refresh(ctx context.Context, store *state.Store, client spindle.StatusFetcher) error {
+	return refreshWith(ctx, store, client, nil)
+}
+
+// refreshWith fetches status and queue concurrently and applies both to the
+// store atomically. An endpoint that answers 304 to a conditional fetch
+// counts as success and keeps its stored copy. When only one fetch fails,
+// the other's result is still applied and the failed side keeps its stored
+// copy, so a blip on one endpoint does not blank the other; the failure is
+// recorded as the snapshot's LastError and returned. Only when both fail
+// is the poll a failure. With a cursor the queue is fetched as a delta
+// when the daemon supports it and merged into the stored queue.
+func refreshWith(ctx context.Context, store *state.Store, client spindle.StatusFetcher, cursor *queueCursor) error {
 	var wg sync.WaitGroup
 	var status *spindle.StatusResponse
 	var queue []spindle.QueueItem
+	var delta bool
+	var commitQueue func()
 	var statusErr, queueErr error
 
+	store.BeginFetch()
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
@@ -90,30 +269,49 @@ func refresh(ctx context.Context, store *state.Store, client *spindle.Client) er
 	}()
 	go func() {
 		defer wg.Done()
-		queue, queueErr = client.FetchQueue(ctx)
+		queue, delta, commitQueue, queueErr = fetchQueue(ctx, client, cursor)
 	}()
 	wg.Wait()
 
-	if statusErr != nil || queueErr != nil {
+	// A 304 means the store already holds the current copy.
+	statusChanged := !errors.Is(statusErr, spindle.ErrNotModified)
+	if !statusChanged {
+		statusErr = nil
+	}
+	queueChanged := !errors.Is(queueErr, spindle.ErrNotModified)
+	if !queueChanged {
+		queueErr = nil
+	}
+
+	if statusErr != nil && queueErr != nil {
 		err := combineFetchErrors(statusErr, queueErr)
 		store.Update(nil, nil, err)
 		return err
 	}
 
-	store.Update(status, queue, nil)
-	return nil
-}
-
-// combineFetchErrors merges the status and queue fetch errors into a single
-// reported failure. When both fail, both messages are included so neither
-// failure is silently dropped.
-func combineFetchErrors(statusErr, queueErr error) error {
+	var partialErr error
 	switch {
-	case statusErr != nil && queueErr != nil:
-		return fmt.Errorf("status: %w; queue: %v", statusErr, queueErr)
 	case statusErr != nil:
-		return statusErr
-	default:
-		return queueErr
+		partialErr = fmt.Errorf("status: %w", statusErr)
+		statusChanged = false
+	case queueErr != nil:
+		partialErr = fmt.Errorf("queue: %w", queueErr)
+		queue, delta, queueChanged = nil, false, false
+	}
+
+	if delta {
+		store.Merge(status, statusChanged, queue, partialErr)
+	} else {
+		store.UpdatePartial(status, statusChanged, queue, queueChanged, partialErr)
 	}
+	if commitQueue != nil {
+		commitQueue()
+	}
+	return partialErr
+}
+
+// combineFetchErrors reports a poll where both fetches failed, keeping
+// both messages so neither failure is silently dropped.
+func combineFetchErrors(statusErr, queueErr error) error {
+	return fmt.Errorf("status: %w; queue: %v", statusErr, queueErr)
 }
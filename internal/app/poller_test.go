@@ -3,13 +3,17 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/five82/flyer/internal/notify"
 	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/spindle/spindletest"
 	"github.com/five82/flyer/internal/state"
 )
 
@@ -23,10 +27,11 @@ func TestCalculateBackoff(t *testing.T) {
 	}{
 		{"zero failures", 0, 2 * time.Second},
 		{"negative failures", -1, 2 * time.Second},
-		{"one failure", 1, 4 * time.Second},
-		{"two failures", 2, 8 * time.Second},
-		{"three failures", 3, 16 * time.Second},
-		{"four failures capped", 4, 30 * time.Second}, // Would be 32s, capped to 30s
+		{"one failure retries at interval", 1, 2 * time.Second},
+		{"two failures", 2, 4 * time.Second},
+		{"three failures", 3, 8 * time.Second},
+		{"four failures", 4, 16 * time.Second},
+		{"five failures capped", 5, 30 * time.Second}, // Would be 32s, capped to 30s
 		{"many failures capped", 10, 30 * time.Second},
 	}
 
@@ -91,10 +96,48 @@ func TestRefresh_BothSucceedUpdatesStore(t *testing.T) {
 	}
 }
 
-// TestRefresh_OneFailureLeavesStoreUntouched verifies that when only one of
-// the two concurrent fetches fails, the store keeps its previous data (no
-// partial update) and the failure is recorded.
-func TestRefresh_OneFailureLeavesStoreUntouched(t *testing.T) {
+// TestRefresh_FetchingDuringPoll verifies the store reports a poll in
+// flight while the fetches run and clears it once the result is recorded,
+// whether the poll succeeded or failed.
+func TestRefresh_FetchingDuringPoll(t *testing.T) {
+	for _, fail := range []bool{false, true} {
+		var store state.Store
+		var inFlight atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if store.Snapshot().Fetching {
+				inFlight.Add(1)
+			}
+			if fail {
+				http.Error(w, "boom", http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/api/status":
+				_ = json.NewEncoder(w).Encode(spindle.StatusResponse{Running: true})
+			default:
+				_ = json.NewEncoder(w).Encode(spindle.QueueListResponse{})
+			}
+		}))
+
+		err := refresh(context.Background(), &store, newTestClient(t, server.URL))
+		server.Close()
+		if (err != nil) != fail {
+			t.Fatalf("fail=%v: refresh() error = %v", fail, err)
+		}
+		if got := inFlight.Load(); got != 2 {
+			t.Fatalf("fail=%v: Fetching seen by %d of 2 requests", fail, got)
+		}
+		if store.Snapshot().Fetching {
+			t.Fatalf("fail=%v: Fetching still set after refresh", fail)
+		}
+	}
+}
+
+// TestRefresh_QueueFailureKeepsQueueAndUpdatesStatus verifies that when
+// only the queue fetch fails, the new status is applied, the previous queue
+// is kept, and the failure is recorded without counting as a failed poll.
+func TestRefresh_QueueFailureKeepsQueueAndUpdatesStatus(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		switch r.URL.Path {
@@ -122,11 +165,41 @@ func TestRefresh_OneFailureLeavesStoreUntouched(t *testing.T) {
 	}
 
 	snap := store.Snapshot()
-	if snap.Status.PID != 1 || len(snap.Queue) != 1 || snap.Queue[0].ID != 1 {
-		t.Fatalf("store should keep previous data on partial failure, got status=%#v queue=%#v", snap.Status, snap.Queue)
+	if snap.Status.PID != 123 {
+		t.Fatalf("status PID = %d, want the fresh status applied", snap.Status.PID)
 	}
-	if snap.LastError == nil {
-		t.Fatalf("snapshot LastError = nil, want recorded failure")
+	if len(snap.Queue) != 1 || snap.Queue[0].ID != 1 {
+		t.Fatalf("queue = %#v, want the previous queue kept", snap.Queue)
+	}
+	if snap.LastError == nil || !strings.HasPrefix(snap.LastError.Error(), "queue: ") {
+		t.Fatalf("snapshot LastError = %v, want recorded queue failure", snap.LastError)
+	}
+	if snap.ConsecutiveFailures != 0 {
+		t.Fatalf("ConsecutiveFailures = %d, want 0 for a partial failure", snap.ConsecutiveFailures)
+	}
+}
+
+func TestRefresh_StatusFailureKeepsStatusAndUpdatesQueue(t *testing.T) {
+	fake := (&spindletest.FakeClient{}).
+		ScriptStatus(nil, fmt.Errorf("status endpoint down")).
+		ScriptQueue([]spindle.QueueItem{{ID: 2}, {ID: 3}}, nil)
+
+	var store state.Store
+	store.Update(&spindle.StatusResponse{PID: 1}, []spindle.QueueItem{{ID: 1}}, nil)
+
+	err := refresh(context.Background(), &store, fake)
+	if err == nil || !strings.Contains(err.Error(), "status endpoint down") {
+		t.Fatalf("refresh() error = %v, want status failure", err)
+	}
+	snap := store.Snapshot()
+	if snap.Status.PID != 1 || !snap.HasStatus {
+		t.Fatalf("status = %#v, want the previous status kept", snap.Status)
+	}
+	if len(snap.Queue) != 2 || snap.Queue[0].ID != 2 {
+		t.Fatalf("queue = %#v, want the fresh queue applied", snap.Queue)
+	}
+	if snap.LastError == nil || snap.ConsecutiveFailures != 0 {
+		t.Fatalf("LastError=%v failures=%d, want recorded error without a failed poll", snap.LastError, snap.ConsecutiveFailures)
 	}
 }
 
@@ -149,3 +222,309 @@ func TestRefresh_BothFailCombinesErrorMessages(t *testing.T) {
 		t.Fatalf("refresh() error = %v, want it to mention both status and queue failures", err)
 	}
 }
+
+// TestRefresh_StatusChangedQueueNotModified verifies the mixed conditional
+// case: a changed status is applied while a 304 queue keeps its copy.
+func TestRefresh_StatusChangedQueueNotModified(t *testing.T) {
+	var statusCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/status":
+			n := statusCalls.Add(1)
+			w.Header().Set("ETag", fmt.Sprintf(`"s%d"`, n))
+			_ = json.NewEncoder(w).Encode(spindle.StatusResponse{Running: true, PID: int(n)})
+		case "/api/queue":
+			if r.Header.Get("If-None-Match") == `"q1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"q1"`)
+			_ = json.NewEncoder(w).Encode(spindle.QueueListResponse{Items: []spindle.QueueItem{{ID: 42}}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := spindle.NewClient(server.URL, spindle.WithETags())
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	var store state.Store
+
+	for i := range 2 {
+		if err := refresh(context.Background(), &store, client); err != nil {
+			t.Fatalf("refresh() #%d error = %v, want nil", i+1, err)
+		}
+	}
+
+	snap := store.Snapshot()
+	if snap.Status.PID != 2 {
+		t.Fatalf("status pid = %d, want 2 (changed status applied)", snap.Status.PID)
+	}
+	if len(snap.Queue) != 1 || snap.Queue[0].ID != 42 {
+		t.Fatalf("queue = %#v, want unchanged item 42", snap.Queue)
+	}
+	if snap.LastError != nil {
+		t.Fatalf("LastError = %v, want nil (304 is not a failure)", snap.LastError)
+	}
+}
+
+func TestRefreshWith_MergesQueueDeltas(t *testing.T) {
+	var fullCalls, deltaCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/status":
+			_ = json.NewEncoder(w).Encode(spindle.StatusResponse{Running: true})
+		case "/api/queue":
+			fullCalls.Add(1)
+			_ = json.NewEncoder(w).Encode(spindle.QueueListResponse{Items: []spindle.QueueItem{
+				{ID: 1, Stage: "ripping", UpdatedAt: "2026-01-02T10:00:00Z"},
+				{ID: 2, Stage: "pending", UpdatedAt: "2026-01-02T09:00:00Z"},
+			}})
+		case "/api/queue/delta":
+			n := deltaCalls.Add(1)
+			want := "2026-01-02T10:00:00Z"
+			if n > 1 {
+				want = "2026-01-02T10:00:05Z"
+			}
+			if got := r.URL.Query().Get("since"); got != want {
+				t.Errorf("delta #%d since = %q, want %q", n, got, want)
+			}
+			var items []spindle.QueueItem
+			if n == 1 {
+				items = []spindle.QueueItem{{ID: 2, Stage: "encoding", UpdatedAt: "2026-01-02T10:00:04Z"}}
+			}
+			_ = json.NewEncoder(w).Encode(spindle.QueueDelta{Items: items, ServerTime: time.Date(2026, 1, 2, 10, 0, 5*int(n), 0, time.UTC)})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(t, server.URL)
+	var store state.Store
+	cursor := &queueCursor{}
+
+	for i := range 3 {
+		if err := refreshWith(context.Background(), &store, client, cursor); err != nil {
+			t.Fatalf("refresh #%d error = %v, want nil", i+1, err)
+		}
+	}
+	if fullCalls.Load() != 1 || deltaCalls.Load() != 2 {
+		t.Fatalf("full=%d delta=%d fetches, want 1 full then 2 deltas", fullCalls.Load(), deltaCalls.Load())
+	}
+	snap := store.Snapshot()
+	if len(snap.Queue) != 2 || snap.Queue[0].Stage != "ripping" || snap.Queue[1].Stage != "encoding" {
+		t.Fatalf("queue = %#v, want item 2 updated by the delta and item 1 kept", snap.Queue)
+	}
+}
+
+func TestRefreshWith_FallsBackWhenDeltaUnsupported(t *testing.T) {
+	var fullCalls, deltaCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/status":
+			_ = json.NewEncoder(w).Encode(spindle.StatusResponse{Running: true})
+		case "/api/queue":
+			n := fullCalls.Add(1)
+			_ = json.NewEncoder(w).Encode(spindle.QueueListResponse{Items: []spindle.QueueItem{
+				{ID: int64(n), UpdatedAt: "2026-01-02T10:00:00Z"},
+			}})
+		case "/api/queue/delta":
+			deltaCalls.Add(1)
+			http.NotFound(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := newTestClient(t, server.URL)
+	var store state.Store
+	cursor := &queueCursor{}
+
+	for i := range 3 {
+		if err := refreshWith(context.Background(), &store, client, cursor); err != nil {
+			t.Fatalf("refresh #%d error = %v, want nil", i+1, err)
+		}
+	}
+	if deltaCalls.Load() != 1 || fullCalls.Load() != 3 {
+		t.Fatalf("full=%d delta=%d fetches, want one delta probe then full fetches", fullCalls.Load(), deltaCalls.Load())
+	}
+	if snap := store.Snapshot(); len(snap.Queue) != 1 || snap.Queue[0].ID != 3 {
+		t.Fatalf("queue = %#v, want the latest full fetch", snap.Queue)
+	}
+}
+
+func TestQueueCursor_ForcesPeriodicFullFetch(t *testing.T) {
+	cursor := &queueCursor{}
+	if _, ok := cursor.next(); ok {
+		t.Fatalf("fresh cursor should require a full fetch")
+	}
+	cursor.reset([]spindle.QueueItem{{ID: 1, UpdatedAt: "2026-01-02T10:00:00Z"}}, true)
+	for i := range queueDeltaFullEvery {
+		if _, ok := cursor.next(); !ok {
+			t.Fatalf("poll %d should use a delta", i+1)
+		}
+	}
+	if _, ok := cursor.next(); ok {
+		t.Fatalf("cursor should force a full fetch after %d deltas", queueDeltaFullEvery)
+	}
+	cursor.reset(nil, false)
+	if since, ok := cursor.next(); !ok || since.Hour() != 10 {
+		t.Fatalf("304 full fetch should keep the cursor, got %v ok=%v", since, ok)
+	}
+}
+
+func TestFetchQueue_CursorMovesOnlyOnCommit(t *testing.T) {
+	since := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	fake := (&spindletest.FakeClient{}).
+		ScriptQueueDelta(spindle.QueueDelta{ServerTime: since.Add(5 * time.Second)}, nil)
+	cursor := &queueCursor{since: since}
+
+	_, delta, commit, err := fetchQueue(context.Background(), fake, cursor)
+	if err != nil || !delta || commit == nil {
+		t.Fatalf("fetchQueue = delta %v, commit %v, err %v; want a delta to commit", delta, commit != nil, err)
+	}
+	if !cursor.since.Equal(since) {
+		t.Fatalf("cursor moved to %v before commit", cursor.since)
+	}
+	commit()
+	if !cursor.since.Equal(since.Add(5 * time.Second)) {
+		t.Fatalf("cursor = %v after commit, want the delta's server time", cursor.since)
+	}
+}
+
+func TestRefreshWith_FakeClientScripts(t *testing.T) {
+	fake := (&spindletest.FakeClient{}).
+		ScriptStatus(&spindle.StatusResponse{Running: true}, nil).
+		ScriptStatus(nil, fmt.Errorf("status unavailable")).
+		ScriptQueue([]spindle.QueueItem{{ID: 1}}, nil).
+		ScriptQueue(nil, fmt.Errorf("queue unavailable"))
+
+	var store state.Store
+	cursor := &queueCursor{}
+	if err := refreshWith(context.Background(), &store, fake, cursor); err != nil {
+		t.Fatalf("first refresh: %v", err)
+	}
+	snap := store.Snapshot()
+	if !snap.Status.Running || len(snap.Queue) != 1 || snap.Queue[0].ID != 1 {
+		t.Fatalf("after success snapshot = %+v", snap)
+	}
+
+	err := refreshWith(context.Background(), &store, fake, cursor)
+	if err == nil || !strings.Contains(err.Error(), "queue unavailable") {
+		t.Fatalf("second refresh error = %v, want queue failure", err)
+	}
+	snap = store.Snapshot()
+	if len(snap.Queue) != 1 || snap.ConsecutiveFailures != 1 {
+		t.Fatalf("after failure queue=%d failures=%d, want last queue kept and 1 failure", len(snap.Queue), snap.ConsecutiveFailures)
+	}
+	if got := fake.Calls("FetchQueueDelta"); got != 0 {
+		t.Fatalf("FetchQueueDelta calls = %d, want 0 before a cursor exists", got)
+	}
+}
+
+func TestPollSchedule_Next(t *testing.T) {
+	active := state.Snapshot{Queue: []spindle.QueueItem{
+		{ID: 1, Stage: "completed"},
+		{ID: 2, Tasks: []spindle.Task{{Type: "encoding", State: "running"}}},
+	}}
+	idle := state.Snapshot{Queue: []spindle.QueueItem{{ID: 1, Stage: "completed"}}}
+	offline := active
+	offline.ConsecutiveFailures = 2
+
+	adaptive := PollSchedule{Interval: 2 * time.Second, Min: time.Second, Max: 5 * time.Second}
+	tests := []struct {
+		name     string
+		schedule PollSchedule
+		snap     state.Snapshot
+		want     time.Duration
+	}{
+		{"fixed active", PollSchedule{Interval: 3 * time.Second}, active, 3 * time.Second},
+		{"fixed idle", PollSchedule{Interval: 3 * time.Second}, idle, 3 * time.Second},
+		{"unset interval", PollSchedule{}, idle, defaultPollInterval},
+		{"active uses min", adaptive, active, time.Second},
+		{"idle uses max", adaptive, idle, 5 * time.Second},
+		{"empty queue is idle", adaptive, state.Snapshot{}, 5 * time.Second},
+		{"offline uses max", adaptive, offline, 5 * time.Second},
+		{"only min idle", PollSchedule{Interval: 2 * time.Second, Min: time.Second}, idle, 2 * time.Second},
+		{"only max active", PollSchedule{Interval: 2 * time.Second, Max: 5 * time.Second}, active, 2 * time.Second},
+		{"min above max", PollSchedule{Min: 8 * time.Second, Max: 5 * time.Second}, active, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.next(tt.snap); got != tt.want {
+				t.Errorf("next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPoller_EndpointChangeStartsOver(t *testing.T) {
+	fake := (&spindletest.FakeClient{}).
+		ScriptStatus(&spindle.StatusResponse{Running: true}, nil).
+		ScriptQueue([]spindle.QueueItem{{ID: 2, Stage: "failed"}}, nil).
+		ScriptQueueDelta(spindle.QueueDelta{}, nil)
+
+	var store state.Store
+	store.SetEndpoint("http://a:7487")
+	var problems []notify.Problem
+	p := &poller{store: &store, client: fake, schedule: PollSchedule{Interval: time.Second},
+		endpoint: store.Endpoint(), onProblem: func(pr notify.Problem) { problems = append(problems, pr) }}
+	p.cursor.reset([]spindle.QueueItem{{ID: 1, UpdatedAt: "2026-01-02T10:00:00Z"}}, true)
+	_ = p.problems.Update(nil) // primed by the old daemon
+
+	store.SetEndpoint("http://b:7487")
+	p.poll(context.Background(), time.Now())
+	if fake.Calls("FetchQueueDelta") != 0 || fake.Calls("FetchQueue") != 1 {
+		t.Fatalf("delta=%d full=%d fetches, want a full fetch from the new daemon",
+			fake.Calls("FetchQueueDelta"), fake.Calls("FetchQueue"))
+	}
+	if len(problems) != 0 {
+		t.Fatalf("new daemon's existing problems alerted: %+v", problems)
+	}
+	if snap := store.Snapshot(); len(snap.Queue) != 1 || snap.Queue[0].ID != 2 {
+		t.Fatalf("queue = %+v, want the new daemon's queue", snap.Queue)
+	}
+}
+
+func TestPoller_BacksOffThenRecovers(t *testing.T) {
+	down := fmt.Errorf("connection refused")
+	fake := &spindletest.FakeClient{}
+	for range 5 {
+		fake.ScriptStatus(nil, down).ScriptQueue(nil, down)
+	}
+	fake.ScriptStatus(&spindle.StatusResponse{Running: true}, nil).
+		ScriptQueue([]spindle.QueueItem{{ID: 1}}, nil)
+
+	var store state.Store
+	p := &poller{store: &store, client: fake, schedule: PollSchedule{Interval: 2 * time.Second}}
+	now := time.Unix(1_700_000_000, 0)
+
+	wantDelays := []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second}
+	for i, want := range wantDelays {
+		if got := p.poll(context.Background(), now); got != want {
+			t.Fatalf("failure %d delay = %v, want %v", i+1, got, want)
+		}
+		snap := store.Snapshot()
+		if snap.ConsecutiveFailures != i+1 {
+			t.Fatalf("failure %d ConsecutiveFailures = %d", i+1, snap.ConsecutiveFailures)
+		}
+		if !snap.RetryAt.Equal(now.Add(want)) {
+			t.Fatalf("failure %d RetryAt = %v, want %v", i+1, snap.RetryAt, now.Add(want))
+		}
+	}
+
+	if got := p.poll(context.Background(), now); got != 2*time.Second {
+		t.Fatalf("recovery delay = %v, want the normal interval", got)
+	}
+	snap := store.Snapshot()
+	if snap.ConsecutiveFailures != 0 || !snap.RetryAt.IsZero() || len(snap.Queue) != 1 {
+		t.Fatalf("after recovery failures=%d retryAt=%v queue=%d, want reset state", snap.ConsecutiveFailures, snap.RetryAt, len(snap.Queue))
+	}
+}
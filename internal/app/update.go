@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// updateCheckTimeout bounds the startup release lookup.
+const updateCheckTimeout = 5 * time.Second
+
+// newUpdateCheck returns a func that reports a release newer than current
+// published at url, or "" when there is none or the lookup fails. It
+// returns nil, skipping the check entirely, when url is empty or current
+// is not a release version (a "dev" build).
+func newUpdateCheck(ctx context.Context, url, current string) func() string {
+	url = strings.TrimSpace(url)
+	if url == "" {
+		return nil
+	}
+	if _, ok := parseVersion(current); !ok {
+		return nil
+	}
+	return func() string {
+		latest, err := fetchLatestVersion(ctx, url)
+		if err != nil || !newerVersion(latest, current) {
+			return ""
+		}
+		return latest
+	}
+}
+
+// buildVersion returns the module version Flyer was built with, or "dev"
+// for local builds. Go reports those as "(devel)", whose parentheses are
+// not valid in a User-Agent product token.
+func buildVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok || info.Main.Version == "" || info.Main.Version == "(devel)" {
+		return "dev"
+	}
+	return info.Main.Version
+}
+
+// fetchLatestVersion reads the latest release version from url. The body
+// may be a GitHub release object ({"tag_name": "v1.2.3"}) or the bare
+// version as plain text.
+func fetchLatestVersion(ctx context.Context, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, updateCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("update check returned %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return "", err
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if json.Unmarshal(body, &release) == nil && release.TagName != "" {
+		return strings.TrimSpace(release.TagName), nil
+	}
+	return strings.TrimSpace(string(body)), nil
+}
+
+// newerVersion reports whether latest is a higher release than current.
+// Both are "vMAJOR.MINOR.PATCH" with optional v prefix and -pre/+build
+// suffixes (ignored); anything unparseable is never newer.
+func newerVersion(latest, current string) bool {
+	l, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+	c, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+	for i := range l {
+		if l[i] != c[i] {
+			return l[i] > c[i]
+		}
+	}
+	return false
+}
+
+// parseVersion splits a semantic version into its numeric parts.
+func parseVersion(v string) ([3]int, bool) {
+	var parts [3]int
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	fields := strings.Split(v, ".")
+	if len(fields) != 3 {
+		return parts, false
+	}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNewerVersion(t *testing.T) {
+	cases := []struct {
+		latest, current string
+		want            bool
+	}{
+		{"v1.2.4", "v1.2.3", true},
+		{"1.10.0", "v1.9.9", true},
+		{"v2.0.0", "v1.99.99", true},
+		{"v1.2.3", "v1.2.3", false},
+		{"v1.2.2", "v1.2.3", false},
+		{"v1.3.0-rc.1", "v1.2.3", true},
+		{"v1.2.3+build.5", "v1.2.3", false},
+		{"latest", "v1.2.3", false},
+		{"v1.2", "v1.0.0", false},
+		{"v1.2.4", "(devel)", false},
+	}
+	for _, tc := range cases {
+		if got := newerVersion(tc.latest, tc.current); got != tc.want {
+			t.Errorf("newerVersion(%q, %q) = %v, want %v", tc.latest, tc.current, got, tc.want)
+		}
+	}
+}
+
+func TestNewUpdateCheck_SkippedWhenDisabled(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	t.Cleanup(server.Close)
+
+	if check := newUpdateCheck(context.Background(), "  ", "v1.0.0"); check != nil {
+		t.Fatalf("empty URL should disable the check")
+	}
+	if check := newUpdateCheck(context.Background(), server.URL, "dev"); check != nil {
+		t.Fatalf("devel build should skip the check")
+	}
+	if hits.Load() != 0 {
+		t.Fatalf("disabled checks made %d requests", hits.Load())
+	}
+}
+
+func TestNewUpdateCheck_ReportsNewerRelease(t *testing.T) {
+	body := `{"tag_name":"v1.4.0","name":"Flyer 1.4.0"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	if got := newUpdateCheck(context.Background(), server.URL, "v1.3.2")(); got != "v1.4.0" {
+		t.Fatalf("check() = %q, want v1.4.0", got)
+	}
+	if got := newUpdateCheck(context.Background(), server.URL, "v1.4.0")(); got != "" {
+		t.Fatalf("check() on the latest release = %q, want empty", got)
+	}
+	body = "v1.5.0\n"
+	if got := newUpdateCheck(context.Background(), server.URL, "v1.4.0")(); got != "v1.5.0" {
+		t.Fatalf("check() with a plain-text body = %q, want v1.5.0", got)
+	}
+}
+
+func TestBuildVersion_LocalBuildIsDev(t *testing.T) {
+	// Test binaries carry no module version, like a local build.
+	if got := buildVersion(); got != "dev" {
+		t.Fatalf("buildVersion() = %q, want dev", got)
+	}
+}
@@ -1,12 +1,14 @@
 package config
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	toml "github.com/pelletier/go-toml/v2"
 )
@@ -17,6 +19,10 @@ type Config struct {
 	APIBind  string
 	APIToken string
 	StateDir string
+
+	// Warnings lists problems that did not stop loading, such as paths
+	// referencing unset environment variables.
+	Warnings []string
 }
 
 const defaultStateDir = "~/.local/state/spindle"
@@ -25,24 +31,14 @@ const defaultStateDir = "~/.local/state/spindle"
 // allowed so explicit --api/--token remote access does not require local
 // Spindle configuration.
 func Load(path string) (Config, error) {
-	resolved, err := resolvePath(path)
+	cfg := Config{StateDir: mustExpand(defaultStateDir)}
+	cfg.warnUnset("config path", path)
+	data, err := readConfig(path)
 	if err != nil {
 		return Config{}, err
 	}
-
-	cfg := Config{StateDir: mustExpand(defaultStateDir)}
-	file, err := os.Open(resolved)
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return cfg, nil
-		}
-		return Config{}, fmt.Errorf("open config: %w", err)
-	}
-	defer func() { _ = file.Close() }()
-
-	data, err := io.ReadAll(file)
-	if err != nil {
-		return Config{}, fmt.Errorf("read config: %w", err)
+	if data == nil {
+		return cfg, nil
 	}
 
 	var raw struct {
@@ -61,11 +57,146 @@ func Load(path string) (Config, error) {
 	cfg.APIBind = strings.TrimSpace(raw.API.Bind)
 	cfg.APIToken = strings.TrimSpace(raw.API.Token)
 	if stateDir := strings.TrimSpace(raw.Paths.StateDir); stateDir != "" {
-		cfg.StateDir = mustExpand(stateDir)
+		cfg.setStateDir(stateDir)
 	}
 	return cfg, nil
 }
 
+// Profile is one named Spindle daemon Flyer can monitor.
+type Profile struct {
+	Name string
+	Config
+}
+
+// defaultProfileName names the single profile built from the top-level
+// [api] and [paths] sections.
+const defaultProfileName = "default"
+
+// LoadProfiles reads the daemons listed as [[profiles]] tables, each with
+// name, bind, token and state_dir keys mirroring [api] and [paths]. A file
+// without profiles (every Spindle config) yields a single "default"
+// profile from Load, so existing setups keep working unchanged.
+func LoadProfiles(path string) ([]Profile, error) {
+	data, err := readConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Profiles []struct {
+			Name     string `toml:"name"`
+			Bind     string `toml:"bind"`
+			Token    string `toml:"token"`
+			StateDir string `toml:"state_dir"`
+		} `toml:"profiles"`
+	}
+	if data != nil {
+		if err := toml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("parse config: %w", err)
+		}
+	}
+	if len(raw.Profiles) == 0 {
+		cfg, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		return []Profile{{Name: defaultProfileName, Config: cfg}}, nil
+	}
+
+	profiles := make([]Profile, 0, len(raw.Profiles))
+	seen := make(map[string]bool, len(raw.Profiles))
+	for i, p := range raw.Profiles {
+		name := strings.TrimSpace(p.Name)
+		if name == "" {
+			return nil, fmt.Errorf("profile %d: name is required", i+1)
+		}
+		if seen[strings.ToLower(name)] {
+			return nil, fmt.Errorf("profile %q: duplicate name", name)
+		}
+		seen[strings.ToLower(name)] = true
+
+		cfg := Config{
+			APIBind:  strings.TrimSpace(p.Bind),
+			APIToken: strings.TrimSpace(p.Token),
+			StateDir: mustExpand(defaultStateDir),
+		}
+		if stateDir := strings.TrimSpace(p.StateDir); stateDir != "" {
+			cfg.setStateDir(stateDir)
+		}
+		profiles = append(profiles, Profile{Name: name, Config: cfg})
+	}
+	return profiles, nil
+}
+
+// readConfig returns the config file's contents, or nil when it does not
+// exist.
+func readConfig(path string) ([]byte, error) {
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(resolved)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("open config: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	return data, nil
+}
+
+// watchInterval is how often Watch checks the config file for changes.
+const watchInterval = 2 * time.Second
+
+// Watch polls the config at path until ctx is done and, whenever its
+// modification time or size changes, re-parses it and passes the result
+// to onChange. A reload that fails goes to onError (when non-nil) instead,
+// leaving the previous config in effect. A missing file is not a change;
+// polling keeps Flyer free of platform-specific watchers.
+func Watch(ctx context.Context, path string, onChange func(Config), onError func(error)) {
+	go watch(ctx, path, watchInterval, onChange, onError)
+}
+
+func watch(ctx context.Context, path string, interval time.Duration, onChange func(Config), onError func(error)) {
+	resolved, err := resolvePath(path)
+	if err != nil {
+		if onError != nil {
+			onError(err)
+		}
+		return
+	}
+	last, _ := os.Stat(resolved)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		info, err := os.Stat(resolved)
+		if err != nil || (last != nil && info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size()) {
+			continue
+		}
+		last = info
+		cfg, err := Load(path)
+		if err != nil {
+			if onError != nil {
+				onError(err)
+			}
+			continue
+		}
+		onChange(cfg)
+	}
+}
+
 // DaemonLogPath returns Spindle's active daemon-log link.
 func (c Config) DaemonLogPath() string {
 	stateDir := strings.TrimSpace(c.StateDir)
@@ -75,6 +206,36 @@ func (c Config) DaemonLogPath() string {
 	return filepath.Join(stateDir, "daemon.log")
 }
 
+// setStateDir expands a configured state_dir. One that expands to nothing
+// (every variable in it unset) keeps the default.
+func (c *Config) setStateDir(stateDir string) {
+	c.warnUnset("state_dir", stateDir)
+	if expanded, err := expandPath(stateDir); err == nil {
+		c.StateDir = expanded
+	}
+}
+
+// warnUnset records a warning for each unset environment variable path
+// references.
+func (c *Config) warnUnset(setting, path string) {
+	for _, name := range unsetVars(path) {
+		c.Warnings = append(c.Warnings, fmt.Sprintf("%s: $%s is not set", setting, name))
+	}
+}
+
+// unsetVars returns the environment variables referenced as $VAR or
+// ${VAR} in s that are not set.
+func unsetVars(s string) []string {
+	var unset []string
+	os.Expand(s, func(name string) string {
+		if _, ok := os.LookupEnv(name); !ok {
+			unset = append(unset, name)
+		}
+		return ""
+	})
+	return unset
+}
+
 func resolvePath(path string) (string, error) {
 	if strings.TrimSpace(path) != "" {
 		return expandPath(path)
@@ -94,8 +255,10 @@ func mustExpand(path string) string {
 	return expanded
 }
 
+// expandPath expands $VAR and ${VAR} (unset variables become empty), then
+// a leading ~, and returns the absolute path.
 func expandPath(path string) (string, error) {
-	trimmed := strings.TrimSpace(path)
+	trimmed := strings.TrimSpace(os.ExpandEnv(path))
 	if trimmed == "" {
 		return "", fmt.Errorf("path is empty")
 	}
@@ -1,10 +1,12 @@
 package config
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestLoadMissingConfigUsesLocalPathDefaults(t *testing.T) {
@@ -118,8 +120,208 @@ func TestExpandPathExpandsTildeAndReturnsAbs(t *testing.T) {
 	}
 }
 
+func TestExpandPathExpandsEnvironmentVariables(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(home, "data"))
+
+	tests := map[string]string{
+		"$HOME/spindle":            filepath.Join(home, "spindle"),
+		"${XDG_DATA_HOME}/spindle": filepath.Join(home, "data", "spindle"),
+		"~/$FLYER_UNSET_VAR/state": filepath.Join(home, "state"),
+	}
+	for in, want := range tests {
+		got, err := expandPath(in)
+		if err != nil {
+			t.Fatalf("expandPath(%q): %v", in, err)
+		}
+		if got != want {
+			t.Fatalf("expandPath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestLoadWarnsOnUnsetVariables(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(home, "data"))
+	path := filepath.Join(home, "config.toml")
+	data := "[paths]\nstate_dir = \"${XDG_DATA_HOME}/$FLYER_UNSET_VAR/spindle\"\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if want := filepath.Join(home, "data", "spindle"); cfg.StateDir != want {
+		t.Fatalf("StateDir = %q, want %q", cfg.StateDir, want)
+	}
+	if len(cfg.Warnings) != 1 || !strings.Contains(cfg.Warnings[0], "$FLYER_UNSET_VAR") {
+		t.Fatalf("Warnings = %q, want one for $FLYER_UNSET_VAR", cfg.Warnings)
+	}
+}
+
+func TestLoadStateDirOfOnlyUnsetVariablesKeepsDefault(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := filepath.Join(home, "config.toml")
+	if err := os.WriteFile(path, []byte("[paths]\nstate_dir = \"$FLYER_UNSET_VAR\"\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if want := filepath.Join(home, ".local", "state", "spindle"); cfg.StateDir != want {
+		t.Fatalf("StateDir = %q, want default %q", cfg.StateDir, want)
+	}
+	if len(cfg.Warnings) != 1 {
+		t.Fatalf("Warnings = %q, want one", cfg.Warnings)
+	}
+}
+
 func TestExpandPathEmptyErrors(t *testing.T) {
 	if _, err := expandPath("   "); err == nil {
 		t.Fatal("expandPath returned nil error")
 	}
 }
+
+func TestLoadProfilesLegacyConfigIsDefaultProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("[api]\nbind = \"127.0.0.1:7487\"\ntoken = \"t\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		t.Fatalf("LoadProfiles: %v", err)
+	}
+	if len(profiles) != 1 || profiles[0].Name != "default" || profiles[0].APIBind != "127.0.0.1:7487" || profiles[0].APIToken != "t" {
+		t.Fatalf("profiles = %+v, want one default profile from [api]", profiles)
+	}
+
+	missing, err := LoadProfiles(filepath.Join(home, "none.toml"))
+	if err != nil || len(missing) != 1 || missing[0].StateDir != filepath.Join(home, ".local", "state", "spindle") {
+		t.Fatalf("missing file profiles = %+v, %v, want one default profile", missing, err)
+	}
+}
+
+func TestLoadProfilesParsesNamedDaemons(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := filepath.Join(t.TempDir(), "config.toml")
+	content := `
+[api]
+bind = "127.0.0.1:7487"
+
+[[profiles]]
+name = " basement "
+bind = "10.0.0.5:7487"
+token = "secret"
+state_dir = "~/spindle-basement"
+
+[[profiles]]
+name = "office"
+bind = "10.0.0.6:7487"
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		t.Fatalf("LoadProfiles: %v", err)
+	}
+	if len(profiles) != 2 {
+		t.Fatalf("profiles = %+v, want 2 (top-level [api] ignored)", profiles)
+	}
+	basement, office := profiles[0], profiles[1]
+	if basement.Name != "basement" || basement.APIBind != "10.0.0.5:7487" || basement.APIToken != "secret" ||
+		basement.StateDir != filepath.Join(home, "spindle-basement") {
+		t.Fatalf("basement = %+v", basement)
+	}
+	if office.Name != "office" || office.StateDir != filepath.Join(home, ".local", "state", "spindle") {
+		t.Fatalf("office = %+v", office)
+	}
+}
+
+func TestLoadProfilesRejectsBadNames(t *testing.T) {
+	cases := map[string]string{
+		"missing":   "[[profiles]]\nbind = \"a:1\"\n",
+		"duplicate": "[[profiles]]\nname = \"a\"\n[[profiles]]\nname = \"A\"\n",
+	}
+	for name, content := range cases {
+		path := filepath.Join(t.TempDir(), "config.toml")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadProfiles(path); err == nil {
+			t.Errorf("%s name: LoadProfiles returned nil error", name)
+		}
+	}
+}
+
+func TestWatchReloadsOnChange(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	path := filepath.Join(t.TempDir(), "config.toml")
+	write := func(content string, mtime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		// Distinct mtimes so same-size rewrites register on coarse clocks.
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := time.Now().Add(-time.Hour)
+	write("[api]\nbind = \"10.0.0.1:7487\"\n", start)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan Config, 4)
+	errs := make(chan error, 4)
+	go watch(ctx, path, 5*time.Millisecond, func(cfg Config) { changes <- cfg }, func(err error) { errs <- err })
+
+	select {
+	case cfg := <-changes:
+		t.Fatalf("callback fired without a change: %+v", cfg)
+	case <-time.After(30 * time.Millisecond):
+	}
+
+	write("[api]\nbind = \"10.0.0.2:7487\"\n", start.Add(time.Minute))
+	select {
+	case cfg := <-changes:
+		if cfg.APIBind != "10.0.0.2:7487" {
+			t.Fatalf("reloaded APIBind = %q, want 10.0.0.2:7487", cfg.APIBind)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("callback did not fire after the file changed")
+	}
+
+	write("[api", start.Add(2*time.Minute))
+	select {
+	case err := <-errs:
+		if !strings.Contains(err.Error(), "parse config") {
+			t.Fatalf("reload error = %v, want parse error", err)
+		}
+	case cfg := <-changes:
+		t.Fatalf("invalid config reached onChange: %+v", cfg)
+	case <-time.After(2 * time.Second):
+		t.Fatal("onError did not fire for an invalid config")
+	}
+
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+	write("[api]\nbind = \"10.0.0.3:7487\"\n", start.Add(3*time.Minute))
+	select {
+	case cfg := <-changes:
+		t.Fatalf("callback fired after cancel: %+v", cfg)
+	case <-time.After(30 * time.Millisecond):
+	}
+}
@@ -0,0 +1,3 @@
+// Package export serializes queue snapshots for reporting, as JSON or CSV,
+// log buffers as plain text, and an item's problems as a plain-text report.
+package export
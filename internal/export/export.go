@@ -0,0 +1,114 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// WriteJSON writes items as an indented JSON array of full queue items,
+// with their timestamps normalized to RFC3339 in UTC.
+func WriteJSON(w io.Writer, items []spindle.QueueItem) error {
+	normalized := make([]spindle.QueueItem, len(items))
+	for i, item := range items {
+		normalized[i] = normalizeItem(item)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(normalized)
+}
+
+// Columns derives the CSV columns that depend on how Flyer classifies
+// items. A nil func leaves its column empty.
+type Columns struct {
+	Lane     func(spindle.QueueItem) string
+	Progress func(spindle.QueueItem) float64 // percent, 0-100
+}
+
+// csvHeader names the CSV columns.
+var csvHeader = []string{"id", "title", "status", "lane", "progress", "updated"}
+
+// WriteCSV writes one row per item: ID, title, status (the item's stage),
+// lane, progress percent and RFC3339 update time.
+func WriteCSV(w io.Writer, items []spindle.QueueItem, cols Columns) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, item := range items {
+		var lane, progress string
+		if cols.Lane != nil {
+			lane = cols.Lane(item)
+		}
+		if cols.Progress != nil {
+			progress = strconv.FormatFloat(cols.Progress(item), 'f', 1, 64)
+		}
+		row := []string{
+			strconv.FormatInt(item.ID, 10),
+			title(item),
+			item.Stage,
+			lane,
+			progress,
+			normalizeTime(item.UpdatedAt),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteLines writes lines as plain text, one per line, with terminal
+// escape sequences (colors and styles) stripped.
+func WriteLines(w io.Writer, lines []string) error {
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(ansi.Strip(line))
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// title returns the item's display title, falling back to the disc title.
+func title(item spindle.QueueItem) string {
+	if item.DisplayTitle != "" {
+		return item.DisplayTitle
+	}
+	return item.DiscTitle
+}
+
+// normalizeItem returns a copy of item with its own and its tasks'
+// timestamps normalized.
+func normalizeItem(item spindle.QueueItem) spindle.QueueItem {
+	item.CreatedAt = normalizeTime(item.CreatedAt)
+	item.UpdatedAt = normalizeTime(item.UpdatedAt)
+	if item.Tasks != nil {
+		tasks := make([]spindle.Task, len(item.Tasks))
+		for i, t := range item.Tasks {
+			t.StartedAt = normalizeTime(t.StartedAt)
+			t.FinishedAt = normalizeTime(t.FinishedAt)
+			tasks[i] = t
+		}
+		item.Tasks = tasks
+	}
+	return item
+}
+
+// normalizeTime rewrites an RFC3339 timestamp (any precision or offset) as
+// RFC3339 in UTC. Empty and unparseable values are returned unchanged.
+func normalizeTime(value string) string {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return value
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,125 @@
+package export
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+func sampleQueue() []spindle.QueueItem {
+	return []spindle.QueueItem{
+		{
+			ID:           7,
+			DiscTitle:    "HEAT_1995",
+			DisplayTitle: "Heat (1995)",
+			Stage:        "encoding",
+			CreatedAt:    "2026-05-01T09:00:00.123456-04:00",
+			UpdatedAt:    "2026-05-01T10:30:00-04:00",
+			Tasks: []spindle.Task{
+				{Type: "ripping", State: "done", StartedAt: "2026-05-01T09:01:00-04:00", FinishedAt: "2026-05-01T09:40:00-04:00"},
+				{Type: "encoding", State: "running", StartedAt: "2026-05-01T09:41:00-04:00", Progress: spindle.TaskProgress{Percent: 42.5}},
+			},
+		},
+		{
+			ID:        8,
+			DiscTitle: "Disc, \"Two\"",
+			Stage:     "pending",
+			UpdatedAt: "not a time",
+		},
+	}
+}
+
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("write golden: %v", err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("%s mismatch:\n got:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func TestWriteJSON_Golden(t *testing.T) {
+	items := sampleQueue()
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, items); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	assertGolden(t, "queue.json", buf.Bytes())
+
+	if items[0].UpdatedAt != "2026-05-01T10:30:00-04:00" || items[0].Tasks[0].StartedAt != "2026-05-01T09:01:00-04:00" {
+		t.Fatal("WriteJSON modified the caller's items")
+	}
+}
+
+func TestWriteCSV_Golden(t *testing.T) {
+	cols := Columns{
+		Lane: func(item spindle.QueueItem) string {
+			if item.Stage == "encoding" {
+				return "Running"
+			}
+			return "Waiting"
+		},
+		Progress: func(item spindle.QueueItem) float64 {
+			if item.ID == 7 {
+				return 42.5
+			}
+			return 0
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, sampleQueue(), cols); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	assertGolden(t, "queue.csv", buf.Bytes())
+}
+
+func TestWriteCSV_NilColumnsLeaveCellsEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, sampleQueue()[:1], Columns{}); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	want := "id,title,status,lane,progress,updated\n7,Heat (1995),encoding,,,2026-05-01T14:30:00Z\n"
+	if buf.String() != want {
+		t.Fatalf("WriteCSV = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestWriteJSON_EmptyQueueIsEmptyArray(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, nil); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	if buf.String() != "[]\n" {
+		t.Fatalf("WriteJSON(nil) = %q, want []", buf.String())
+	}
+}
+
+func TestWriteLines_StripsEscapes(t *testing.T) {
+	var buf bytes.Buffer
+	lines := []string{
+		"\x1b[31mERROR\x1b[0m disk full",
+		"\x1b[1;38;2;255;200;0mWARN\x1b[m slow read",
+		"plain",
+	}
+	if err := WriteLines(&buf, lines); err != nil {
+		t.Fatalf("WriteLines: %v", err)
+	}
+	want := "ERROR disk full\nWARN slow read\nplain\n"
+	if buf.String() != want {
+		t.Fatalf("WriteLines = %q, want %q", buf.String(), want)
+	}
+}
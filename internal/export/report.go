@@ -0,0 +1,109 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// WriteProblemReport writes a plain-text report of an item's problems for
+// pasting into an issue: identity and status, then each kind of problem
+// the item has. Sections without data are omitted.
+func WriteProblemReport(w io.Writer, item spindle.QueueItem) error {
+	var b strings.Builder
+	field := func(label, value string) {
+		if value = strings.TrimSpace(value); value != "" {
+			fmt.Fprintf(&b, "%s: %s\n", label, value)
+		}
+	}
+	section := func(title string) {
+		fmt.Fprintf(&b, "\n%s:\n", title)
+	}
+
+	fmt.Fprintf(&b, "Item #%d: %s\n", item.ID, item.FullTitle())
+	field("Disc", item.DiscTitle)
+	status := item.Stage
+	if stage := strings.TrimSpace(item.FailedAtStage); stage != "" {
+		status += " (at " + stage + ")"
+	}
+	if item.NeedsReview {
+		status += ", needs review"
+	}
+	field("Status", status)
+	field("Updated", normalizeTime(item.UpdatedAt))
+
+	if task := item.FailedTask(); task != nil {
+		section("Failed task")
+		fmt.Fprintf(&b, "  %s (attempts: %d)\n", task.Type, task.Attempts)
+		if msg := strings.TrimSpace(task.Error); msg != "" {
+			fmt.Fprintf(&b, "  %s\n", msg)
+		}
+	}
+
+	if item.NeedsReview && len(item.ReviewReasons) > 0 {
+		section("Review reasons")
+		for _, reason := range item.ReviewReasons {
+			if reason = strings.TrimSpace(reason); reason != "" {
+				fmt.Fprintf(&b, "  - %s\n", reason)
+			}
+		}
+	}
+
+	if msg := strings.TrimSpace(item.ErrorMessage); msg != "" {
+		section("Error")
+		fmt.Fprintf(&b, "  %s\n", msg)
+	}
+
+	if failed := spindle.FilterFailed(item.Episodes); len(failed) > 0 {
+		section("Failed episodes")
+		for _, ep := range failed {
+			label := fmt.Sprintf("S%02dE%02d", ep.Season, ep.Episode)
+			if title := strings.TrimSpace(ep.Title); title != "" {
+				label += " " + title
+			}
+			if msg := strings.TrimSpace(ep.ErrorMessage); msg != "" {
+				label += ": " + msg
+			}
+			fmt.Fprintf(&b, "  - %s\n", label)
+		}
+	}
+
+	if enc := item.Encoding; enc != nil {
+		if issue := enc.Error; issue != nil {
+			section("Encoding error")
+			for _, f := range [][2]string{
+				{"Title", issue.Title},
+				{"Message", issue.Message},
+				{"Context", issue.Context},
+				{"Suggestion", issue.Suggestion},
+			} {
+				if v := strings.TrimSpace(f[1]); v != "" {
+					fmt.Fprintf(&b, "  %s: %s\n", f[0], v)
+				}
+			}
+		}
+		if v := enc.Validation; v != nil && (!v.Passed || len(v.Steps) > 0) {
+			section("Validation " + passLabel(v.Passed))
+			for _, step := range v.Steps {
+				fmt.Fprintf(&b, "  [%s] %s", passLabel(step.Passed), step.Name)
+				if details := strings.TrimSpace(step.Details); details != "" {
+					fmt.Fprintf(&b, ": %s", details)
+				}
+				b.WriteString("\n")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// passLabel renders a validation result.
+func passLabel(passed bool) string {
+	if passed {
+		return "passed"
+	}
+	return "FAILED"
+}
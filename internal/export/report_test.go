@@ -0,0 +1,49 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+func TestWriteProblemReport_Golden(t *testing.T) {
+	item := spindle.QueueItem{
+		ID:            12,
+		DiscTitle:     "THE_WIRE_S1_D1",
+		DisplayTitle:  "The Wire",
+		Stage:         "failed",
+		FailedAtStage: "encoding",
+		UpdatedAt:     "2026-05-01T10:30:00-04:00",
+		NeedsReview:   true,
+		ReviewReasons: []string{"episode match below threshold"},
+		ErrorMessage:  "encoding failed after 3 attempts",
+		Tasks: []spindle.Task{
+			{Type: "ripping", State: "done"},
+			{Type: "encoding", State: "failed", Attempts: 3, Error: "ffmpeg exited 1"},
+		},
+		Episodes: []spindle.EpisodeStatus{
+			{Season: 1, Episode: 1, Title: "The Target"},
+			{Season: 1, Episode: 2, Title: "The Detail", Status: "failed", ErrorMessage: "encoder crashed"},
+		},
+		Encoding: &spindle.EncodingStatus{
+			Error: &spindle.EncodingIssue{
+				Title:      "Encoder crashed",
+				Message:    "SvtAv1 returned an error",
+				Context:    "frame 1200 of 48000",
+				Suggestion: "Retry with a lower preset",
+			},
+			Validation: &spindle.EncodingValidation{
+				Steps: []spindle.EncodingValidationStep{
+					{Name: "duration", Passed: true},
+					{Name: "audio tracks", Details: "expected 2, found 1"},
+				},
+			},
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteProblemReport(&buf, item); err != nil {
+		t.Fatalf("WriteProblemReport: %v", err)
+	}
+	assertGolden(t, "problem_report.txt", buf.Bytes())
+}
@@ -0,0 +1,3 @@
+// Package notify raises desktop notifications when queue items newly fail
+// or need review.
+package notify
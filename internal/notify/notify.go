@@ -0,0 +1,107 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// Problem is an item that has just entered a state needing attention.
+type Problem struct {
+	ItemID int64
+	Title  string
+	Kind   string // "failed" or "review"
+	Detail string // error message or review reasons; may be empty
+}
+
+// Tracker detects items newly entering the failed or review state across
+// successive queue snapshots. The first snapshot only primes it, so items
+// already failed when Flyer starts do not alert.
+type Tracker struct {
+	seen   map[int64]problemState
+	primed bool
+}
+
+// problemState records which attention states an item was last seen in.
+type problemState struct {
+	failed bool
+	review bool
+}
+
+// Update records queue as the latest snapshot and returns the problems it
+// introduces: items that are failed or in review now but were not in that
+// state in the previous snapshot. An item that recovers and fails again
+// alerts again.
+func (t *Tracker) Update(queue []spindle.QueueItem) []Problem {
+	next := make(map[int64]problemState, len(queue))
+	var problems []Problem
+	for _, item := range queue {
+		state := problemState{
+			failed: strings.EqualFold(item.Stage, "failed"),
+			review: item.NeedsReview,
+		}
+		if !state.failed && !state.review {
+			continue
+		}
+		next[item.ID] = state
+		prev := t.seen[item.ID]
+		if !t.primed {
+			continue
+		}
+		if state.failed && !prev.failed {
+			problems = append(problems, newProblem(item, "failed", item.ErrorMessage))
+		}
+		if state.review && !prev.review {
+			problems = append(problems, newProblem(item, "review", strings.Join(item.ReviewReasons, "; ")))
+		}
+	}
+	t.seen = next
+	t.primed = true
+	return problems
+}
+
+func newProblem(item spindle.QueueItem, kind, detail string) Problem {
+	title := item.DisplayTitle
+	if title == "" {
+		title = item.DiscTitle
+	}
+	if title == "" {
+		title = fmt.Sprintf("Item #%d", item.ID)
+	}
+	return Problem{ItemID: item.ID, Title: title, Kind: kind, Detail: strings.TrimSpace(detail)}
+}
+
+// ErrUnsupported reports a platform without a known notification command.
+var ErrUnsupported = errors.New("desktop notifications unsupported")
+
+// Send shows p as a desktop notification through notify-send (Linux and
+// BSDs) or osascript (macOS).
+func Send(ctx context.Context, p Problem) error {
+	summary := fmt.Sprintf("#%d %s", p.ItemID, p.Kind)
+	body := p.Title
+	if p.Detail != "" {
+		body += ": " + p.Detail
+	}
+
+	var cmd *exec.Cmd
+	switch {
+	case runtime.GOOS == "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", body, "Flyer "+summary)
+		cmd = exec.CommandContext(ctx, "osascript", "-e", script)
+	default:
+		path, err := exec.LookPath("notify-send")
+		if err != nil {
+			return ErrUnsupported
+		}
+		cmd = exec.CommandContext(ctx, path, "--app-name=Flyer", "Flyer "+summary, body)
+	}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("send notification: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+func TestTracker_FirstUpdateOnlyPrimes(t *testing.T) {
+	var tr Tracker
+	got := tr.Update([]spindle.QueueItem{{ID: 1, Stage: "failed"}, {ID: 2, NeedsReview: true}})
+	if len(got) != 0 {
+		t.Fatalf("first update returned %d problems, want 0", len(got))
+	}
+	if got := tr.Update([]spindle.QueueItem{{ID: 1, Stage: "failed"}, {ID: 2, NeedsReview: true}}); len(got) != 0 {
+		t.Fatalf("unchanged queue returned %d problems, want 0", len(got))
+	}
+}
+
+func TestTracker_NewlyFailedAndReview(t *testing.T) {
+	var tr Tracker
+	tr.Update([]spindle.QueueItem{{ID: 1, Stage: "encoding"}, {ID: 2, Stage: "ripping"}})
+
+	got := tr.Update([]spindle.QueueItem{
+		{ID: 1, Stage: "failed", DisplayTitle: "Movie", ErrorMessage: "disk full"},
+		{ID: 2, Stage: "ripping", NeedsReview: true, ReviewReasons: []string{"low confidence", "no match"}},
+	})
+	want := []Problem{
+		{ItemID: 1, Title: "Movie", Kind: "failed", Detail: "disk full"},
+		{ItemID: 2, Title: "Item #2", Kind: "review", Detail: "low confidence; no match"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d problems, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("problem %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestTracker_ReviewItemThatFailsAlertsOnce(t *testing.T) {
+	var tr Tracker
+	tr.Update([]spindle.QueueItem{{ID: 1, NeedsReview: true}})
+
+	got := tr.Update([]spindle.QueueItem{{ID: 1, Stage: "failed", NeedsReview: true}})
+	if len(got) != 1 || got[0].Kind != "failed" {
+		t.Fatalf("got %+v, want a single failed problem", got)
+	}
+}
+
+func TestTracker_RealertsAfterRecovery(t *testing.T) {
+	var tr Tracker
+	tr.Update(nil)
+	if got := tr.Update([]spindle.QueueItem{{ID: 1, Stage: "failed"}}); len(got) != 1 {
+		t.Fatalf("first failure: got %d problems, want 1", len(got))
+	}
+	tr.Update([]spindle.QueueItem{{ID: 1, Stage: "encoding"}})
+	if got := tr.Update([]spindle.QueueItem{{ID: 1, Stage: "failed"}}); len(got) != 1 {
+		t.Fatalf("second failure: got %d problems, want 1", len(got))
+	}
+}
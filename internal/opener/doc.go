@@ -0,0 +1,4 @@
+// Package opener hands finished output files to the desktop's default
+// application, and decides whether a daemon endpoint is local enough for
+// its paths to exist on this machine.
+package opener
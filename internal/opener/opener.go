@@ -0,0 +1,76 @@
+package opener
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Opener opens a path with the desktop's default application.
+type Opener interface {
+	Open(path string) error
+}
+
+// System opens paths with the OS default opener (xdg-open, open, or
+// start). The zero value is ready to use.
+type System struct{}
+
+// Open launches the default opener on path without waiting for the
+// application it starts.
+func (System) Open(path string) error {
+	name, args := Command(runtime.GOOS, path)
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("open %s: %w", path, err)
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
+
+// Command returns the default opener invocation for path on goos.
+func Command(goos, path string) (name string, args []string) {
+	switch goos {
+	case "darwin":
+		return "open", []string{path}
+	case "windows":
+		// start's first quoted argument is the window title.
+		return "cmd", []string{"/c", "start", "", path}
+	default:
+		return "xdg-open", []string{path}
+	}
+}
+
+// IsLocalEndpoint reports whether a daemon API endpoint -- host:port, a
+// URL, or a unix socket path -- is on this machine, so the file paths it
+// reports can be opened here. Empty, loopback, unspecified (0.0.0.0)
+// hosts and this machine's hostname count as local.
+func IsLocalEndpoint(endpoint string) bool {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" || strings.HasPrefix(endpoint, "/") || strings.HasPrefix(endpoint, "unix:") {
+		return true
+	}
+	host := endpoint
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return false
+		}
+		host = u.Hostname()
+	} else if h, _, err := net.SplitHostPort(endpoint); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+
+	if host == "" || strings.EqualFold(host, "localhost") {
+		return true
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsLoopback() || ip.IsUnspecified()
+	}
+	name, err := os.Hostname()
+	return err == nil && strings.EqualFold(host, name)
+}
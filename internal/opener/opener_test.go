@@ -0,0 +1,55 @@
+package opener
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	tests := []struct {
+		goos     string
+		wantName string
+		wantArgs []string
+	}{
+		{"linux", "xdg-open", []string{"/media/Heat.mkv"}},
+		{"freebsd", "xdg-open", []string{"/media/Heat.mkv"}},
+		{"darwin", "open", []string{"/media/Heat.mkv"}},
+		{"windows", "cmd", []string{"/c", "start", "", "/media/Heat.mkv"}},
+	}
+	for _, tt := range tests {
+		name, args := Command(tt.goos, "/media/Heat.mkv")
+		if name != tt.wantName || !slices.Equal(args, tt.wantArgs) {
+			t.Errorf("Command(%q) = %q %q, want %q %q", tt.goos, name, args, tt.wantName, tt.wantArgs)
+		}
+	}
+}
+
+func TestIsLocalEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     bool
+	}{
+		{"", true},
+		{"127.0.0.1:7487", true},
+		{"localhost:7487", true},
+		{"http://localhost:7487", true},
+		{"http://[::1]:7487", true},
+		{"0.0.0.0:7487", true},
+		{"/run/spindle/api.sock", true},
+		{"http://server:7487", false},
+		{"https://spindle.example.com", false},
+		{"192.168.1.20:7487", false},
+	}
+	for _, tt := range tests {
+		if got := IsLocalEndpoint(tt.endpoint); got != tt.want {
+			t.Errorf("IsLocalEndpoint(%q) = %v, want %v", tt.endpoint, got, tt.want)
+		}
+	}
+
+	if name, err := os.Hostname(); err == nil && name != "" {
+		if !IsLocalEndpoint("http://" + name + ":7487") {
+			t.Errorf("IsLocalEndpoint(own hostname %q) = false, want true", name)
+		}
+	}
+}
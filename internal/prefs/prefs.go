@@ -14,8 +14,126 @@ import (
 // Prefs holds user preferences for Flyer.
 type Prefs struct {
 	Theme string `toml:"theme"`
+
+	// LogFollowIdleSeconds re-enables log follow after this many seconds
+	// without log-view input once the operator has scrolled away from the
+	// tail. Zero keeps follow off until toggled manually.
+	LogFollowIdleSeconds int `toml:"log_follow_idle_seconds"`
+
+	// LogHighlights are always-on log view highlights, independent of
+	// search.
+	LogHighlights []LogHighlight `toml:"log_highlight"`
+
+	// AutoExpandActiveEpisodes expands the episode list of a multi-episode
+	// item while it has running work, so per-episode progress shows without
+	// pressing t.
+	AutoExpandActiveEpisodes bool `toml:"auto_expand_active_episodes"`
+
+	// HealthDetailWidth caps the header's dependency-health detail on
+	// regular terminals; compact terminals use half. Zero uses 80.
+	HealthDetailWidth int `toml:"health_detail_width"`
+
+	// HealthRotateSeconds cycles the header through each unhealthy
+	// dependency every this many seconds instead of showing the first with
+	// "+N more". Zero disables rotation.
+	HealthRotateSeconds int `toml:"health_rotate_seconds"`
+
+	// QueueLaneColumn adds a LANE column (Attention, Running, Waiting,
+	// Done) to the queue table on terminals at least 80 columns wide.
+	QueueLaneColumn bool `toml:"queue_lane_column"`
+
+	// LaneColors overrides the theme's lane colors, keyed by lane name
+	// (case-insensitive). Values use the LogHighlight color forms plus the
+	// muted and faint roles.
+	LaneColors map[string]string `toml:"lane_colors"`
+
+	// DetailRefreshMillis re-renders the inspector Overview this often,
+	// extrapolating running progress and ETA between snapshots. Zero
+	// renders on snapshots only.
+	DetailRefreshMillis int `toml:"detail_refresh_ms"`
+
+	// ETAClockTime follows relative ETAs with the estimated completion
+	// clock time, e.g. "ETA 1h 20m (done ~15:42)".
+	ETAClockTime bool `toml:"eta_clock_time"`
+
+	// QueueFollowIdleSeconds selects an item in the queue as it starts
+	// running, unless the queue was navigated within this many seconds.
+	// Zero disables following.
+	QueueFollowIdleSeconds int `toml:"queue_follow_idle_seconds"`
+
+	// DetailFooter puts quick stats for the inspected item (stage, lane,
+	// percent, ETA, episodes) in the Overview panel's bottom border, so
+	// they stay visible while scrolled.
+	DetailFooter bool `toml:"detail_footer"`
+
+	// LogCollapseRepeats renders runs of identical consecutive log lines
+	// once with a repeat count. Search still sees every line.
+	LogCollapseRepeats bool `toml:"log_collapse_repeats"`
+
+	// UpdateCheckURL is fetched once at startup for the latest Flyer
+	// release (a GitHub release JSON or a bare version); a newer one shows
+	// a hint in the header. Empty disables the check.
+	UpdateCheckURL string `toml:"update_check_url"`
+
+	// CompactMode overrides the width-based compact header and NOW band:
+	// "always", "never", or "auto" (the default) for compact below 100
+	// columns.
+	CompactMode string `toml:"compact_mode"`
+
+	// QueueFilter is the queue filter restored at startup and saved when
+	// cycled: "all" (the default), "failed", "review", "active" or "pinned".
+	QueueFilter string `toml:"queue_filter"`
+
+	// QueueAbsoluteTimes shows the queue's update times as clock times
+	// instead of ages; saved when toggled.
+	QueueAbsoluteTimes bool `toml:"queue_absolute_times"`
+
+	// PinnedItems are the queue item IDs pinned with *, saved when
+	// toggled. The "pinned" queue filter shows only these.
+	PinnedItems []int64 `toml:"pinned_items"`
+
+	// QueueLayout is the queue layout restored at startup and saved when
+	// toggled: "flat" (the default) or "lanes".
+	QueueLayout string `toml:"queue_layout"`
+
+	// LogFollowPaused starts the log view paused rather than following
+	// the tail; saved when follow is toggled.
+	LogFollowPaused bool `toml:"log_follow_paused"`
+
+	// LogWrap wraps log lines wider than the viewport onto indented
+	// continuation rows instead of clipping them; saved when toggled.
+	LogWrap bool `toml:"log_wrap"`
+
+	// NotifyProblems raises a desktop notification (notify-send or
+	// osascript) when an item newly fails or needs review.
+	NotifyProblems bool `toml:"notify_problems"`
+
+	// CompletedWindowHours is how far back the completed view (c) looks
+	// for finished items. Zero uses 24.
+	CompletedWindowHours int `toml:"completed_window_hours"`
+}
+
+// LogHighlight pairs a regular expression with the color its matches
+// render in: a theme role (accent, info, warning, success, danger) or a
+// hex color. An empty color uses the accent role.
+type LogHighlight struct {
+	Pattern string `toml:"pattern"`
+	Color   string `toml:"color"`
 }
 
+// QueueLayout values.
+const (
+	QueueLayoutFlat  = "flat"
+	QueueLayoutLanes = "lanes"
+)
+
+// CompactMode values.
+const (
+	CompactAuto   = "auto"
+	CompactAlways = "always"
+	CompactNever  = "never"
+)
+
 const (
 	defaultPrefsPath = "~/.config/flyer/prefs.toml"
 	defaultTheme     = "Slate"
@@ -26,6 +144,16 @@ func DefaultPath() string {
 	return defaultPrefsPath
 }
 
+// ThemesDir returns the custom themes directory, "themes" beside the
+// preferences file at path.
+func ThemesDir(path string) (string, error) {
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(resolved), "themes"), nil
+}
+
 // Load reads preferences from the given path, falling back to defaults if missing or invalid.
 func Load(path string) Prefs {
 	prefs := Prefs{Theme: defaultTheme}
@@ -47,6 +175,36 @@ func Load(path string) Prefs {
 	if strings.TrimSpace(prefs.Theme) == "" {
 		prefs.Theme = defaultTheme
 	}
+	if prefs.LogFollowIdleSeconds < 0 {
+		prefs.LogFollowIdleSeconds = 0
+	}
+	if prefs.HealthDetailWidth < 0 {
+		prefs.HealthDetailWidth = 0
+	}
+	if prefs.HealthRotateSeconds < 0 {
+		prefs.HealthRotateSeconds = 0
+	}
+	if prefs.DetailRefreshMillis < 0 {
+		prefs.DetailRefreshMillis = 0
+	}
+	if prefs.QueueFollowIdleSeconds < 0 {
+		prefs.QueueFollowIdleSeconds = 0
+	}
+	if prefs.CompletedWindowHours < 0 {
+		prefs.CompletedWindowHours = 0
+	}
+	prefs.QueueFilter = strings.ToLower(strings.TrimSpace(prefs.QueueFilter))
+	if layout := strings.ToLower(strings.TrimSpace(prefs.QueueLayout)); layout == QueueLayoutLanes {
+		prefs.QueueLayout = layout
+	} else {
+		prefs.QueueLayout = QueueLayoutFlat
+	}
+	switch mode := strings.ToLower(strings.TrimSpace(prefs.CompactMode)); mode {
+	case CompactAlways, CompactNever:
+		prefs.CompactMode = mode
+	default:
+		prefs.CompactMode = CompactAuto
+	}
 
 	return prefs
 }
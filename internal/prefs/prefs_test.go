@@ -3,6 +3,7 @@ package prefs
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 )
 
@@ -89,3 +90,127 @@ func TestLoad_InvalidTOMLFallsBackToDefault(t *testing.T) {
 		t.Fatalf("Theme = %q, want %q", p.Theme, defaultTheme)
 	}
 }
+
+func TestLoad_LogFollowIdleSeconds(t *testing.T) {
+	tmp := t.TempDir()
+	prefsFile := filepath.Join(tmp, "prefs.toml")
+	if err := os.WriteFile(prefsFile, []byte("log_follow_idle_seconds = 45\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if p := Load(prefsFile); p.LogFollowIdleSeconds != 45 {
+		t.Fatalf("LogFollowIdleSeconds = %d, want 45", p.LogFollowIdleSeconds)
+	}
+
+	if err := os.WriteFile(prefsFile, []byte("log_follow_idle_seconds = -5\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if p := Load(prefsFile); p.LogFollowIdleSeconds != 0 {
+		t.Fatalf("LogFollowIdleSeconds = %d, want negative clamped to 0", p.LogFollowIdleSeconds)
+	}
+}
+
+func TestLoad_LogHighlights(t *testing.T) {
+	tmp := t.TempDir()
+	prefsFile := filepath.Join(tmp, "prefs.toml")
+	data := "[[log_highlight]]\npattern = \"GPU\"\ncolor = \"warning\"\n\n[[log_highlight]]\npattern = \"item 42\"\n"
+	if err := os.WriteFile(prefsFile, []byte(data), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	p := Load(prefsFile)
+	want := []LogHighlight{{Pattern: "GPU", Color: "warning"}, {Pattern: "item 42"}}
+	if len(p.LogHighlights) != len(want) {
+		t.Fatalf("LogHighlights = %+v, want %+v", p.LogHighlights, want)
+	}
+	for i := range want {
+		if p.LogHighlights[i] != want[i] {
+			t.Fatalf("LogHighlights[%d] = %+v, want %+v", i, p.LogHighlights[i], want[i])
+		}
+	}
+}
+
+func TestLoad_HealthPrefsClampNegative(t *testing.T) {
+	tmp := t.TempDir()
+	prefsFile := filepath.Join(tmp, "prefs.toml")
+	data := "health_detail_width = -1\nhealth_rotate_seconds = -3\n"
+	if err := os.WriteFile(prefsFile, []byte(data), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	p := Load(prefsFile)
+	if p.HealthDetailWidth != 0 || p.HealthRotateSeconds != 0 {
+		t.Fatalf("health prefs = (%d, %d), want negatives clamped to 0", p.HealthDetailWidth, p.HealthRotateSeconds)
+	}
+}
+
+func TestLoad_LaneColors(t *testing.T) {
+	tmp := t.TempDir()
+	prefsFile := filepath.Join(tmp, "prefs.toml")
+	data := "[lane_colors]\nAttention = \"danger\"\nbackfill = \"#123456\"\n"
+	if err := os.WriteFile(prefsFile, []byte(data), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	p := Load(prefsFile)
+	if p.LaneColors["Attention"] != "danger" || p.LaneColors["backfill"] != "#123456" {
+		t.Fatalf("LaneColors = %v, want Attention and backfill overrides", p.LaneColors)
+	}
+}
+
+func TestLoad_CompactModeNormalized(t *testing.T) {
+	tmp := t.TempDir()
+	prefsFile := filepath.Join(tmp, "prefs.toml")
+	for raw, want := range map[string]string{"Always": CompactAlways, " never ": CompactNever, "sometimes": CompactAuto} {
+		if err := os.WriteFile(prefsFile, []byte("compact_mode = \""+raw+"\"\n"), 0o644); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+		if got := Load(prefsFile).CompactMode; got != want {
+			t.Fatalf("CompactMode for %q = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+func TestSave_RoundTripsUIState(t *testing.T) {
+	prefsFile := filepath.Join(t.TempDir(), "prefs.toml")
+	want := Prefs{
+		Theme:           "Nightfox",
+		QueueFilter:     "review",
+		QueueLayout:     QueueLayoutLanes,
+		LogFollowPaused: true,
+		LogWrap:         true,
+		CompactMode:     CompactNever,
+		PinnedItems:     []int64{12, 7},
+	}
+	if err := Save(prefsFile, want); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	got := Load(prefsFile)
+	if got.Theme != want.Theme || got.QueueFilter != want.QueueFilter ||
+		got.QueueLayout != want.QueueLayout || got.LogFollowPaused != want.LogFollowPaused ||
+		got.LogWrap != want.LogWrap || got.CompactMode != want.CompactMode ||
+		!slices.Equal(got.PinnedItems, want.PinnedItems) {
+		t.Fatalf("Load after Save = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoad_OlderAndNewerFiles(t *testing.T) {
+	tmp := t.TempDir()
+	prefsFile := filepath.Join(tmp, "prefs.toml")
+
+	// An older file predates the UI-state keys: they take their defaults.
+	if err := os.WriteFile(prefsFile, []byte("theme = \"Nightfox\"\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	p := Load(prefsFile)
+	if p.Theme != "Nightfox" || p.QueueFilter != "" || p.QueueLayout != QueueLayoutFlat || p.LogFollowPaused {
+		t.Fatalf("older file = %+v, want theme kept and UI-state defaults", p)
+	}
+
+	// A newer file may carry keys and values this version does not know.
+	data := "theme = \"Slate\"\nqueue_layout = \"Stacked\"\nqueue_filter = \" Failed \"\nsome_future_key = 3\n"
+	if err := os.WriteFile(prefsFile, []byte(data), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	p = Load(prefsFile)
+	if p.Theme != "Slate" || p.QueueLayout != QueueLayoutFlat || p.QueueFilter != "failed" {
+		t.Fatalf("newer file = %+v, want known keys read and unknown values defaulted", p)
+	}
+}
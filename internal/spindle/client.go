@@ -1,14 +1,25 @@
 package spindle
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"math/rand/v2"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 )
 
@@ -17,7 +28,9 @@ import (
 type StatusFetcher interface {
 	FetchStatus(ctx context.Context) (*StatusResponse, error)
 	FetchQueue(ctx context.Context) ([]QueueItem, error)
+	FetchQueueDelta(ctx context.Context, since time.Time) (QueueDelta, error)
 	FetchLogs(ctx context.Context, query LogQuery) (LogBatch, error)
+	FetchDependencies(ctx context.Context) ([]DependencyStatus, error)
 }
 
 // Ensure Client implements StatusFetcher at compile time.
@@ -25,26 +38,224 @@ var _ StatusFetcher = (*Client)(nil)
 
 // Client talks to the Spindle HTTP API.
 type Client struct {
-	baseURL   *url.URL
+	baseURL   atomic.Pointer[url.URL] // swapped by SetBaseURL
 	http      *http.Client
-	userAgent string
-	token     string
+	userAgent string                 // built by NewClient from version and userAgentSuffix
+	token     atomic.Pointer[string] // swapped by SetToken
+	retry     RetryOptions
+	tls       *tls.Config
+	optErr    error // first option failure, reported by NewClient
+
+	version         string
+	userAgentSuffix string
+
+	// etags holds the last ETag per conditional path; nil disables
+	// conditional fetches.
+	etagMu sync.Mutex
+	etags  map[string]string
 }
 
+// ErrUnauthorized reports a 401 response: the API token is missing or
+// rejected.
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrItemNotFound reports that the daemon has no queue item with the
+// requested ID.
+var ErrItemNotFound = errors.New("item not found")
+
+// ErrNotModified reports a conditional fetch whose resource is unchanged
+// since the previous response.
+var ErrNotModified = errors.New("not modified")
+
+// ErrQueueDeltaUnsupported reports a daemon without /api/queue/delta;
+// callers fall back to FetchQueue.
+var ErrQueueDeltaUnsupported = errors.New("queue delta unsupported")
+
+// ErrDependenciesUnsupported reports a daemon without /api/dependencies;
+// callers fall back to StatusResponse.Dependencies from FetchStatus.
+var ErrDependenciesUnsupported = errors.New("dependencies endpoint unsupported")
+
+// ErrLogTailUnsupported reports a daemon without /api/logs/tail; callers
+// fall back to FetchLogs.
+var ErrLogTailUnsupported = errors.New("log tail unsupported")
+
 // ClientOption configures optional Client settings.
 type ClientOption func(*Client)
 
 // WithToken sets the bearer token for API authentication.
 func WithToken(token string) ClientOption {
 	return func(c *Client) {
-		c.token = strings.TrimSpace(token)
+		c.SetToken(token)
 	}
 }
 
-const (
-	defaultUserAgent = "flyer/0.1"
-	requestTimeout   = 5 * time.Second
-)
+// WithTimeout sets the per-request timeout (default 5s). Zero disables
+// the timeout; a negative value makes NewClient fail.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.http.Timeout = d
+	}
+}
+
+// WithTransport replaces the HTTP transport, e.g. to tune connection
+// reuse or inject a test round tripper.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		c.http.Transport = rt
+	}
+}
+
+// WithTLSConfig sets the TLS configuration for https:// endpoints. Without
+// it the system roots are used.
+func WithTLSConfig(cfg *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.tls = cfg
+	}
+}
+
+// WithCACert trusts the PEM certificates in path for https:// endpoints,
+// e.g. a private CA in front of a reverse proxy, in addition to the system
+// roots. It extends any config and root pool set with WithTLSConfig.
+func WithCACert(path string) ClientOption {
+	return func(c *Client) {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			c.setOptErr(fmt.Errorf("read CA cert: %w", err))
+			return
+		}
+		if c.tls == nil {
+			c.tls = &tls.Config{MinVersion: tls.VersionTLS12}
+		} else {
+			c.tls = c.tls.Clone()
+		}
+		pool := systemCertPool()
+		if c.tls.RootCAs != nil {
+			pool = c.tls.RootCAs.Clone()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			c.setOptErr(fmt.Errorf("no certificates found in %s", path))
+			return
+		}
+		c.tls.RootCAs = pool
+	}
+}
+
+// systemCertPool returns a copy of the system roots, or an empty pool
+// where they cannot be loaded.
+func systemCertPool() *x509.CertPool {
+	if pool, err := x509.SystemCertPool(); err == nil {
+		return pool
+	}
+	return x509.NewCertPool()
+}
+
+// setOptErr records the first option failure.
+func (c *Client) setOptErr(err error) {
+	if c.optErr == nil {
+		c.optErr = err
+	}
+}
+
+// RetryOptions configures retries of transient request failures: refused
+// connections, timeouts, and 5xx responses. Other 4xx responses and context
+// cancellation fail immediately. MaxAttempts <= 1 disables retries.
+type RetryOptions struct {
+	MaxAttempts int
+	BaseDelay   time.Duration // delay before the first retry, doubled per attempt
+	MaxDelay    time.Duration // cap on a single delay (0 = uncapped)
+	Jitter      float64       // random extra delay as a fraction of the delay (0-1)
+}
+
+// WithRetry enables retries with exponential backoff.
+func WithRetry(opts RetryOptions) ClientOption {
+	return func(c *Client) {
+		c.retry = opts
+	}
+}
+
+// WithETags revalidates status and queue fetches with If-None-Match. A 304
+// response returns ErrNotModified so callers can keep their previous copy.
+func WithETags() ClientOption {
+	return func(c *Client) {
+		c.etags = make(map[string]string)
+	}
+}
+
+// delay returns the backoff before retry n (1-based). A doubling that
+// overflows saturates at the largest Duration before MaxDelay applies.
+func (r RetryOptions) delay(n int) time.Duration {
+	d := r.BaseDelay << (n - 1)
+	if d>>(n-1) != r.BaseDelay {
+		d = math.MaxInt64
+	}
+	if r.MaxDelay > 0 && d > r.MaxDelay {
+		d = r.MaxDelay
+	}
+	if r.Jitter > 0 {
+		if extra := time.Duration(rand.Float64() * r.Jitter * float64(d)); d+extra > d {
+			d += extra
+		}
+	}
+	return d
+}
+
+const requestTimeout = 5 * time.Second
+
+// defaultVersion is the version reported without WithVersion.
+const defaultVersion = "dev"
+
+// WithVersion sets the Flyer version reported in the User-Agent
+// ("flyer/<version>"). A blank version keeps "dev".
+func WithVersion(version string) ClientOption {
+	return func(c *Client) {
+		if version = strings.TrimSpace(version); version != "" {
+			c.version = version
+		}
+	}
+}
+
+// WithUserAgent appends suffix to the default "flyer/<version>"
+// User-Agent, e.g. to tell several Flyer instances apart in the daemon's
+// access logs. A blank suffix keeps the default.
+func WithUserAgent(suffix string) ClientOption {
+	return func(c *Client) {
+		if suffix = strings.TrimSpace(suffix); suffix != "" {
+			c.userAgentSuffix = suffix
+		}
+	}
+}
+
+// SetBaseURL points the client at a different API endpoint, taking effect
+// for the next request. Cached ETags are dropped since they belong to the
+// old daemon. Setting the current endpoint is a no-op.
+func (c *Client) SetBaseURL(apiEndpoint string) error {
+	base, err := parseBaseURL(apiEndpoint)
+	if err != nil {
+		return err
+	}
+	if old := c.baseURL.Load(); old != nil && old.String() == base.String() {
+		return nil
+	}
+	c.baseURL.Store(base)
+	if c.etags != nil {
+		c.etagMu.Lock()
+		clear(c.etags)
+		c.etagMu.Unlock()
+	}
+	return nil
+}
+
+// BaseURL returns the API endpoint the client currently talks to.
+func (c *Client) BaseURL() string {
+	return c.baseURL.Load().String()
+}
+
+// SetToken replaces the bearer token, taking effect for the next request.
+// A blank token stops sending one.
+func (c *Client) SetToken(token string) {
+	token = strings.TrimSpace(token)
+	c.token.Store(&token)
+}
 
 // NewClient builds a client for a Spindle TCP API endpoint.
 func NewClient(apiEndpoint string, opts ...ClientOption) (*Client, error) {
@@ -53,25 +264,56 @@ func NewClient(apiEndpoint string, opts ...ClientOption) (*Client, error) {
 		return nil, err
 	}
 	c := &Client{
-		baseURL: base,
 		http: &http.Client{
 			Timeout: requestTimeout,
 		},
-		userAgent: defaultUserAgent,
+		version: defaultVersion,
 	}
+	c.baseURL.Store(base)
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.optErr != nil {
+		return nil, c.optErr
+	}
+	c.userAgent = "flyer/" + c.version
+	if c.userAgentSuffix != "" {
+		c.userAgent += " " + c.userAgentSuffix
+	}
+	if c.http.Timeout < 0 {
+		return nil, fmt.Errorf("invalid client timeout %v", c.http.Timeout)
+	}
+	if c.tls != nil {
+		if err := c.applyTLS(); err != nil {
+			return nil, err
+		}
+	}
 	return c, nil
 }
 
+// applyTLS installs the TLS config on the client's transport.
+func (c *Client) applyTLS() error {
+	var transport *http.Transport
+	switch rt := c.http.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = rt.Clone()
+	default:
+		return fmt.Errorf("TLS options need an *http.Transport, got %T", rt)
+	}
+	transport.TLSClientConfig = c.tls
+	c.http.Transport = transport
+	return nil
+}
+
 // FetchStatus retrieves daemon and workflow status information.
 func (c *Client) FetchStatus(ctx context.Context) (*StatusResponse, error) {
 	if c == nil {
 		return nil, fmt.Errorf("client is nil")
 	}
 	var payload StatusResponse
-	if err := c.do(ctx, http.MethodGet, "/api/status", &payload); err != nil {
+	if err := c.doConditional(ctx, "/api/status", &payload); err != nil {
 		return nil, err
 	}
 	return &payload, nil
@@ -83,12 +325,76 @@ func (c *Client) FetchQueue(ctx context.Context) ([]QueueItem, error) {
 		return nil, fmt.Errorf("client is nil")
 	}
 	var payload QueueListResponse
-	if err := c.do(ctx, http.MethodGet, "/api/queue", &payload); err != nil {
+	if err := c.doConditional(ctx, "/api/queue", &payload); err != nil {
 		return nil, err
 	}
 	return payload.Items, nil
 }
 
+// FetchDependencies retrieves just the daemon's dependency health, which is
+// cheaper than a full status fetch.
+func (c *Client) FetchDependencies(ctx context.Context) ([]DependencyStatus, error) {
+	if c == nil {
+		return nil, fmt.Errorf("client is nil")
+	}
+	rel := &url.URL{Path: "/api/dependencies"}
+	var payload DependenciesResponse
+	if err := c.doRequest(ctx, http.MethodGet, rel, &payload, false); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, ErrDependenciesUnsupported
+		}
+		return nil, err
+	}
+	return payload.Dependencies, nil
+}
+
+// QueueDelta lists the queue items updated after a cursor. ServerTime is
+// the cursor for the next request.
+type QueueDelta struct {
+	Items      []QueueItem `json:"items"`
+	ServerTime time.Time   `json:"serverTime"`
+}
+
+// FetchQueueDelta retrieves the queue items updated after since. Removed
+// items are not reported, so callers still fetch the full queue now and
+// then.
+func (c *Client) FetchQueueDelta(ctx context.Context, since time.Time) (QueueDelta, error) {
+	if c == nil {
+		return QueueDelta{}, fmt.Errorf("client is nil")
+	}
+	values := url.Values{}
+	values.Set("since", since.UTC().Format(time.RFC3339Nano))
+	rel := &url.URL{Path: "/api/queue/delta", RawQuery: values.Encode()}
+	var payload QueueDelta
+	if err := c.doRequest(ctx, http.MethodGet, rel, &payload, false); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return QueueDelta{}, ErrQueueDeltaUnsupported
+		}
+		return QueueDelta{}, err
+	}
+	return payload, nil
+}
+
+// FetchItem retrieves a single queue item, for refreshing one item more
+// often than the whole queue.
+func (c *Client) FetchItem(ctx context.Context, id int64) (QueueItem, error) {
+	if c == nil {
+		return QueueItem{}, fmt.Errorf("client is nil")
+	}
+	rel := &url.URL{Path: "/api/queue/" + strconv.FormatInt(id, 10)}
+	var payload QueueItem
+	if err := c.doRequest(ctx, http.MethodGet, rel, &payload, false); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return QueueItem{}, fmt.Errorf("item %d: %w", id, ErrItemNotFound)
+		}
+		return QueueItem{}, err
+	}
+	return payload, nil
+}
+
 // LogQuery configures /api/logs requests.
 type LogQuery struct {
 	Since      uint64
@@ -100,103 +406,286 @@ type LogQuery struct {
 	Lane       string
 	DaemonOnly bool // Only logs without item association (ItemID == 0)
 	Request    string
+	MinLevel   string    // events at or above this level; see FilterMinLevel
+	From       time.Time // events at or after; zero = unbounded
+	To         time.Time // events at or before; zero = unbounded
 }
 
-// FetchLogs retrieves log events using the daemon's streaming API.
-func (c *Client) FetchLogs(ctx context.Context, query LogQuery) (LogBatch, error) {
-	if c == nil {
-		return LogBatch{}, fmt.Errorf("client is nil")
-	}
+// Values encodes the query parameters shared by /api/logs and
+// /api/logs/stream. Empty and zero fields are omitted.
+func (q LogQuery) Values() url.Values {
 	values := url.Values{}
-	if query.Since > 0 {
-		values.Set("since", strconv.FormatUint(query.Since, 10))
+	if q.Since > 0 {
+		values.Set("since", strconv.FormatUint(q.Since, 10))
 	}
-	if query.Limit > 0 {
-		values.Set("limit", strconv.Itoa(query.Limit))
+	if q.Limit > 0 {
+		values.Set("limit", strconv.Itoa(q.Limit))
 	}
-	if query.Tail {
+	if q.Tail {
 		values.Set("tail", "1")
 	}
-	if query.ItemID > 0 {
-		values.Set("item", strconv.FormatInt(query.ItemID, 10))
+	if q.ItemID > 0 {
+		values.Set("item", strconv.FormatInt(q.ItemID, 10))
 	}
-	if level := strings.TrimSpace(query.Level); level != "" {
+	if level := strings.TrimSpace(q.Level); level != "" {
 		values.Set("level", level)
 	}
-	if component := strings.TrimSpace(query.Component); component != "" {
+	if component := strings.TrimSpace(q.Component); component != "" {
 		values.Set("component", component)
 	}
-	if lane := strings.TrimSpace(query.Lane); lane != "" {
+	if lane := strings.TrimSpace(q.Lane); lane != "" {
 		values.Set("lane", lane)
 	}
-	if query.DaemonOnly {
+	if q.DaemonOnly {
 		values.Set("daemon_only", "1")
 	}
-	if req := strings.TrimSpace(query.Request); req != "" {
+	if req := strings.TrimSpace(q.Request); req != "" {
 		values.Set("request", req)
 	}
-	rel := &url.URL{Path: "/api/logs", RawQuery: values.Encode()}
+	if minLevel := strings.TrimSpace(q.MinLevel); minLevel != "" {
+		values.Set("min_level", minLevel)
+	}
+	if !q.From.IsZero() {
+		values.Set("since_ts", q.From.UTC().Format(time.RFC3339))
+	}
+	if !q.To.IsZero() {
+		values.Set("until_ts", q.To.UTC().Format(time.RFC3339))
+	}
+	return values
+}
+
+// FetchLogs retrieves log events using the daemon's streaming API.
+func (c *Client) FetchLogs(ctx context.Context, query LogQuery) (LogBatch, error) {
+	if c == nil {
+		return LogBatch{}, fmt.Errorf("client is nil")
+	}
+	rel := &url.URL{Path: "/api/logs", RawQuery: query.Values().Encode()}
 	var payload LogBatch
-	if err := c.doURL(ctx, http.MethodGet, rel, &payload); err != nil {
+	if err := c.doRequest(ctx, http.MethodGet, rel, &payload, false); err != nil {
 		return LogBatch{}, err
 	}
+	// A daemon without min_level support returns every level; Next still
+	// advances past the dropped events.
+	payload.Events = FilterMinLevel(payload.Events, query.MinLevel)
 	return payload, nil
 }
 
-func (c *Client) do(ctx context.Context, method, path string, dest any) error {
+// LogTailQuery configures /api/logs/tail requests.
+type LogTailQuery struct {
+	ItemID int64 // an item's log; zero tails the daemon log
+	Offset int64 // position to read from: a previous batch's Offset, or -1 for the end
+	Limit  int   // maximum lines; zero uses the daemon's default
+}
+
+// LogTailBatch is a run of raw log lines. Offset is the position after the
+// last line, to pass as the next query's Offset.
+type LogTailBatch struct {
+	Lines  []string `json:"lines"`
+	Offset int64    `json:"offset"`
+}
+
+// FetchLogTail retrieves raw log lines from query.Offset on. An Offset of
+// -1 returns the last Limit lines, for opening a log at its end.
+func (c *Client) FetchLogTail(ctx context.Context, query LogTailQuery) (LogTailBatch, error) {
+	if c == nil {
+		return LogTailBatch{}, fmt.Errorf("client is nil")
+	}
+	values := url.Values{}
+	values.Set("offset", strconv.FormatInt(query.Offset, 10))
+	if query.ItemID > 0 {
+		values.Set("item", strconv.FormatInt(query.ItemID, 10))
+	}
+	if query.Limit > 0 {
+		values.Set("limit", strconv.Itoa(query.Limit))
+	}
+	rel := &url.URL{Path: "/api/logs/tail", RawQuery: values.Encode()}
+	var payload LogTailBatch
+	if err := c.doRequest(ctx, http.MethodGet, rel, &payload, false); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return LogTailBatch{}, ErrLogTailUnsupported
+		}
+		return LogTailBatch{}, err
+	}
+	return payload, nil
+}
+
+// doConditional performs a GET of path, revalidating against the last ETag
+// when the client tracks them.
+func (c *Client) doConditional(ctx context.Context, path string, dest any) error {
 	rel := &url.URL{Path: path}
-	return c.doURL(ctx, method, rel, dest)
+	return c.doRequest(ctx, http.MethodGet, rel, dest, c.etags != nil)
+}
+
+// doRequest performs a request under the client's retry policy.
+func (c *Client) doRequest(ctx context.Context, method string, rel *url.URL, dest any, conditional bool) error {
+	attempts := max(c.retry.MaxAttempts, 1)
+	for n := 1; ; n++ {
+		retryable, err := c.attempt(ctx, method, rel, dest, conditional)
+		if err == nil || !retryable || n >= attempts || ctx.Err() != nil {
+			return err
+		}
+		timer := time.NewTimer(c.retry.delay(n))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
 }
 
-func (c *Client) doURL(ctx context.Context, method string, rel *url.URL, dest any) error {
-	reqURL := c.baseURL.ResolveReference(rel)
+// attempt performs a single request, reporting whether a failure is
+// transient and worth retrying.
+func (c *Client) attempt(ctx context.Context, method string, rel *url.URL, dest any, conditional bool) (bool, error) {
+	reqURL := c.baseURL.Load().ResolveReference(rel)
 	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), nil)
 	if err != nil {
-		return fmt.Errorf("create request: %w", err)
+		return false, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Accept", "application/json")
+	// Setting Accept-Encoding turns off the transport's implicit gzip
+	// handling, so responses are decoded below for any RoundTripper.
+	req.Header.Set("Accept-Encoding", "gzip")
 	req.Header.Set("User-Agent", c.userAgent)
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
+	if token := c.token.Load(); token != nil && *token != "" {
+		req.Header.Set("Authorization", "Bearer "+*token)
+	}
+	if conditional {
+		if etag := c.etag(rel.Path); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
 	}
 
 	resp, err := c.http.Do(req)
 	if err != nil {
-		return fmt.Errorf("execute request: %w", err)
+		return isTransientError(err), fmt.Errorf("execute request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode >= 400 {
-		return apiStatusError(rel, resp)
+	if conditional && resp.StatusCode == http.StatusNotModified {
+		return false, ErrNotModified
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode >= 500, apiStatusError(rel, resp)
 	}
 	if dest == nil {
-		return nil
+		return false, nil
 	}
+	body, err := responseBody(resp)
+	if err != nil {
+		return false, fmt.Errorf("decode response: %w", err)
+	}
+	resp.Body = body
+
 	decoder := json.NewDecoder(resp.Body)
 	if err := decoder.Decode(dest); err != nil {
-		return fmt.Errorf("decode response: %w", err)
+		return false, fmt.Errorf("decode response: %w", err)
 	}
-	return nil
+	if conditional {
+		c.setETag(rel.Path, resp.Header.Get("ETag"))
+	}
+	return false, nil
+}
+
+// responseBody returns a 2xx response body, gunzipped when the server
+// compressed it. Closing the returned reader closes the original body.
+func responseBody(resp *http.Response) (io.ReadCloser, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") || resp.ContentLength == 0 {
+		return resp.Body, nil
+	}
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return gzipBody{Reader: zr, body: resp.Body}, nil
+}
+
+// gzipBody reads through a gzip reader and closes the underlying body.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (g gzipBody) Close() error {
+	_ = g.Reader.Close()
+	return g.body.Close()
+}
+
+// etag returns the last ETag seen for path.
+func (c *Client) etag(path string) string {
+	c.etagMu.Lock()
+	defer c.etagMu.Unlock()
+	return c.etags[path]
+}
+
+// setETag records the ETag for path; an empty tag forgets it.
+func (c *Client) setETag(path, etag string) {
+	c.etagMu.Lock()
+	defer c.etagMu.Unlock()
+	if etag == "" {
+		delete(c.etags, path)
+		return
+	}
+	c.etags[path] = etag
+}
+
+// isTransientError reports whether a transport error is a refused
+// connection or a timeout. Context cancellation is never transient.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
 }
 
 // apiErrorBodyLimit caps how much of an error response body is read when
 // looking for a structured {"error":"..."} message.
 const apiErrorBodyLimit = 4 * 1024
 
-// apiStatusError builds the error for an HTTP status >= 400, preferring the
-// server's structured {"error":"..."} message when the body provides one and
-// falling back to a status-only error otherwise.
-func apiStatusError(rel *url.URL, resp *http.Response) error {
-	body, _ := io.ReadAll(io.LimitReader(resp.Body, apiErrorBodyLimit))
+// APIError is a non-2xx API response. Callers branch on StatusCode with
+// errors.As; a 401 also matches ErrUnauthorized with errors.Is.
+type APIError struct {
+	Endpoint   string // request path and query, e.g. "/api/status"
+	StatusCode int
+	Body       string // response body, truncated to apiErrorBodyLimit
+}
+
+// Error prefers the server's structured {"error":"..."} message when the
+// body provides one and falls back to a status-only message otherwise.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("api %s returned status %d", e.Endpoint, e.StatusCode)
 	var payload struct {
 		Error string `json:"error"`
 	}
-	if err := json.Unmarshal(body, &payload); err == nil {
-		if msg := strings.TrimSpace(payload.Error); msg != "" {
-			return fmt.Errorf("api %s returned status %d: %s", rel.String(), resp.StatusCode, msg)
+	if err := json.Unmarshal([]byte(e.Body), &payload); err == nil {
+		if detail := strings.TrimSpace(payload.Error); detail != "" {
+			msg += ": " + detail
 		}
 	}
-	return fmt.Errorf("api %s returned status %d", rel.String(), resp.StatusCode)
+	return msg
+}
+
+func (e *APIError) Unwrap() error {
+	if e.StatusCode == http.StatusUnauthorized {
+		return ErrUnauthorized
+	}
+	return nil
+}
+
+// apiStatusError builds the APIError for a non-2xx response. A gzipped
+// body is decoded on a best-effort basis; one that fails to decode leaves
+// Body empty rather than masking the status.
+func apiStatusError(rel *url.URL, resp *http.Response) error {
+	var body []byte
+	if r, err := responseBody(resp); err == nil {
+		body, _ = io.ReadAll(io.LimitReader(r, apiErrorBodyLimit))
+	}
+	return &APIError{Endpoint: rel.String(), StatusCode: resp.StatusCode, Body: string(body)}
 }
 
 func parseBaseURL(apiEndpoint string) (*url.URL, error) {
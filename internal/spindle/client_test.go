@@ -1,12 +1,22 @@
 package spindle
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -155,3 +165,999 @@ func TestClient_HTTPErrorIncludesStructuredMessage(t *testing.T) {
 		t.Fatalf("FetchStatus error = %v, want status 400 with structured message", err)
 	}
 }
+
+func TestClient_HTTPErrorIsAPIError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"error":"busy"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	_, err = c.FetchStatus(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("FetchStatus error = %v, want *APIError", err)
+	}
+	if apiErr.Endpoint != "/api/status" || apiErr.StatusCode != http.StatusServiceUnavailable || apiErr.Body != `{"error":"busy"}` {
+		t.Fatalf("APIError = %+v, want /api/status 503 with body", apiErr)
+	}
+	if got, want := err.Error(), "api /api/status returned status 503: busy"; got != want {
+		t.Fatalf("Error() = %q, want %q", got, want)
+	}
+	if errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("503 should not match ErrUnauthorized")
+	}
+}
+
+// flakyServer fails the first n requests with status, then serves an empty
+// queue. It reports the number of requests seen.
+func flakyServer(t *testing.T, n int, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(calls.Add(1)) <= n {
+			http.Error(w, "unavailable", status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestClient_RetriesTransientFailures(t *testing.T) {
+	t.Parallel()
+
+	server, calls := flakyServer(t, 2, http.StatusServiceUnavailable)
+	c, err := NewClient(server.URL, WithRetry(RetryOptions{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	if _, err := c.FetchQueue(context.Background()); err != nil {
+		t.Fatalf("FetchQueue error = %v, want success after retries", err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("requests = %d, want 3", got)
+	}
+}
+
+func TestClient_RetryGivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	server, calls := flakyServer(t, 5, http.StatusServiceUnavailable)
+	c, err := NewClient(server.URL, WithRetry(RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	_, err = c.FetchStatus(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "status 503") {
+		t.Fatalf("FetchStatus error = %v, want status 503", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("requests = %d, want 2", got)
+	}
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	t.Parallel()
+
+	server, calls := flakyServer(t, 1, http.StatusNotFound)
+	c, err := NewClient(server.URL, WithRetry(RetryOptions{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	if _, err := c.FetchLogs(context.Background(), LogQuery{}); err == nil {
+		t.Fatalf("FetchLogs error = nil, want status 404")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("requests = %d, want 1 (4xx must not retry)", got)
+	}
+}
+
+func TestClient_RetriesRefusedConnection(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	addr := server.URL
+	server.Close() // nothing listens here now
+
+	var calls atomic.Int32
+	c, err := NewClient(addr,
+		WithRetry(RetryOptions{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+		WithTransport(countingTransport{&calls, http.DefaultTransport}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	if _, err := c.FetchQueue(context.Background()); err == nil {
+		t.Fatalf("FetchQueue error = nil, want connection refused")
+	}
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("requests = %d, want 3", got)
+	}
+}
+
+func TestClient_RetryStopsOnContextCancel(t *testing.T) {
+	t.Parallel()
+
+	server, calls := flakyServer(t, 5, http.StatusServiceUnavailable)
+	c, err := NewClient(server.URL, WithRetry(RetryOptions{MaxAttempts: 5, BaseDelay: time.Hour}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.FetchQueue(ctx); err == nil {
+		t.Fatalf("FetchQueue error = nil, want failure")
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("requests = %d, want 1 (cancellation must stop retries)", got)
+	}
+}
+
+// countingTransport counts round trips before delegating.
+type countingTransport struct {
+	calls *atomic.Int32
+	next  http.RoundTripper
+}
+
+func (t countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.calls.Add(1)
+	return t.next.RoundTrip(r)
+}
+
+func TestRetryOptionsDelay(t *testing.T) {
+	r := RetryOptions{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	for n, want := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 3: 300 * time.Millisecond, 10: 300 * time.Millisecond} {
+		if got := r.delay(n); got != want {
+			t.Fatalf("delay(%d) = %v, want %v", n, got, want)
+		}
+	}
+
+	r.Jitter = 0.5
+	if got := r.delay(1); got < 100*time.Millisecond || got > 150*time.Millisecond {
+		t.Fatalf("delay(1) with jitter = %v, want within [100ms, 150ms]", got)
+	}
+}
+
+func TestRetryOptionsDelayOverflowSaturates(t *testing.T) {
+	r := RetryOptions{BaseDelay: time.Second, Jitter: 0.5}
+	for _, n := range []int{40, 64, 100} {
+		if got := r.delay(n); got < r.delay(30) {
+			t.Fatalf("uncapped delay(%d) = %v, want saturated, not wrapped", n, got)
+		}
+	}
+	r.MaxDelay = time.Minute
+	if got := r.delay(100); got < time.Minute || got > 90*time.Second {
+		t.Fatalf("capped delay(100) = %v, want MaxDelay plus jitter", got)
+	}
+}
+
+func TestClient_RetriesTimeouts(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	c, err := NewClient(server.URL, WithTimeout(20*time.Millisecond),
+		WithRetry(RetryOptions{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, err := c.FetchQueue(context.Background()); err == nil {
+		t.Fatal("FetchQueue error = nil, want timeout")
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("requests = %d, want 2", got)
+	}
+}
+
+func TestClient_ETagsRevalidate(t *testing.T) {
+	t.Parallel()
+
+	var gotIfNoneMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inm := r.Header.Get("If-None-Match")
+		gotIfNoneMatch = append(gotIfNoneMatch, inm)
+		if inm == `"q1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"q1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[{"id":7}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL, WithETags())
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	items, err := c.FetchQueue(context.Background())
+	if err != nil || len(items) != 1 {
+		t.Fatalf("first FetchQueue = %v, %v; want 1 item", items, err)
+	}
+	if _, err := c.FetchQueue(context.Background()); !errors.Is(err, ErrNotModified) {
+		t.Fatalf("second FetchQueue error = %v, want ErrNotModified", err)
+	}
+	if len(gotIfNoneMatch) != 2 || gotIfNoneMatch[0] != "" || gotIfNoneMatch[1] != `"q1"` {
+		t.Fatalf("If-None-Match = %q, want [\"\" \"q1\"]", gotIfNoneMatch)
+	}
+}
+
+func TestClient_StatusETag200Then304(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == `"s1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"s1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"running":true,"pid":42}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL, WithETags())
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	status, err := c.FetchStatus(context.Background())
+	if err != nil || status == nil || status.PID != 42 {
+		t.Fatalf("first FetchStatus = %+v, %v; want pid 42", status, err)
+	}
+	for range 2 {
+		if _, err := c.FetchStatus(context.Background()); !errors.Is(err, ErrNotModified) {
+			t.Fatalf("revalidated FetchStatus error = %v, want ErrNotModified", err)
+		}
+	}
+	if got := requests.Load(); got != 3 {
+		t.Fatalf("requests = %d, want 3", got)
+	}
+}
+
+func TestClient_ETagInvalidation(t *testing.T) {
+	t.Parallel()
+
+	var sendETag atomic.Bool
+	sendETag.Store(true)
+	var lastIfNoneMatch atomic.Value
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastIfNoneMatch.Store(r.Header.Get("If-None-Match"))
+		if sendETag.Load() {
+			w.Header().Set("ETag", `"s1"`)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"running":true}`))
+	})
+	first := httptest.NewServer(handler)
+	t.Cleanup(first.Close)
+	second := httptest.NewServer(handler)
+	t.Cleanup(second.Close)
+
+	c, err := NewClient(first.URL, WithETags())
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	fetch := func() string {
+		t.Helper()
+		if _, err := c.FetchStatus(context.Background()); err != nil {
+			t.Fatalf("FetchStatus error = %v", err)
+		}
+		return lastIfNoneMatch.Load().(string)
+	}
+
+	fetch()
+	if got := fetch(); got != `"s1"` {
+		t.Fatalf("If-None-Match = %q, want the cached tag", got)
+	}
+
+	// A new endpoint belongs to a different daemon: its tags start empty.
+	if err := c.SetBaseURL(second.URL); err != nil {
+		t.Fatalf("SetBaseURL returned error: %v", err)
+	}
+	if got := fetch(); got != "" {
+		t.Fatalf("If-None-Match after SetBaseURL = %q, want none", got)
+	}
+
+	// A full response without an ETag forgets the cached one.
+	sendETag.Store(false)
+	fetch()
+	if got := fetch(); got != "" {
+		t.Fatalf("If-None-Match after an untagged response = %q, want none", got)
+	}
+}
+
+func TestClient_WithoutETagsSendsNoIfNoneMatch(t *testing.T) {
+	t.Parallel()
+
+	var sawConditional atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			sawConditional.Store(true)
+		}
+		w.Header().Set("ETag", `"s1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"running":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	for range 2 {
+		if _, err := c.FetchStatus(context.Background()); err != nil {
+			t.Fatalf("FetchStatus error = %v", err)
+		}
+	}
+	if sawConditional.Load() {
+		t.Fatalf("client without WithETags sent If-None-Match")
+	}
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	for _, opts := range [][]ClientOption{
+		nil,
+		{WithVersion("v1.4.2")},
+		{WithUserAgent("desk-2"), WithVersion("v1.4.2")},
+		{WithVersion("  "), WithUserAgent("  ")},
+	} {
+		c, err := NewClient(server.URL, opts...)
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		if _, err := c.FetchQueue(context.Background()); err != nil {
+			t.Fatalf("FetchQueue error = %v", err)
+		}
+	}
+	want := []string{"flyer/dev", "flyer/v1.4.2", "flyer/v1.4.2 desk-2", "flyer/dev"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("User-Agent headers = %q, want %q", got, want)
+	}
+}
+
+func TestClient_BearerToken(t *testing.T) {
+	t.Parallel()
+
+	var gotAuth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	for _, token := range []string{"secret", "  "} {
+		c, err := NewClient(server.URL, WithToken(token))
+		if err != nil {
+			t.Fatalf("NewClient returned error: %v", err)
+		}
+		if _, err := c.FetchQueue(context.Background()); err != nil {
+			t.Fatalf("FetchQueue error = %v", err)
+		}
+	}
+	if len(gotAuth) != 2 || gotAuth[0] != "Bearer secret" || gotAuth[1] != "" {
+		t.Fatalf("Authorization headers = %q, want [\"Bearer secret\" \"\"]", gotAuth)
+	}
+}
+
+func TestClient_SetTokenAppliesToNextRequest(t *testing.T) {
+	t.Parallel()
+
+	var gotAuth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL, WithToken("old"))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	for _, token := range []string{"old", " new ", ""} {
+		c.SetToken(token)
+		if _, err := c.FetchQueue(context.Background()); err != nil {
+			t.Fatalf("FetchQueue error = %v", err)
+		}
+	}
+	want := []string{"Bearer old", "Bearer new", ""}
+	if !slices.Equal(gotAuth, want) {
+		t.Fatalf("Authorization headers = %q, want %q", gotAuth, want)
+	}
+}
+
+func TestClient_UnauthorizedIsTyped(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid token"})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL, WithToken("wrong"))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	_, err = c.FetchStatus(context.Background())
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("FetchStatus error = %v, want ErrUnauthorized", err)
+	}
+	if !strings.Contains(err.Error(), "invalid token") {
+		t.Fatalf("FetchStatus error = %v, want structured message kept", err)
+	}
+}
+
+func TestClient_FetchItem(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/queue/7":
+			_, _ = w.Write([]byte(`{"id":7,"stage":"encoding","discTitle":"Alien"}`))
+		case "/api/queue/8":
+			_, _ = w.Write([]byte(`{not-json`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "no such item"})
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	item, err := c.FetchItem(context.Background(), 7)
+	if err != nil || item.ID != 7 || item.Stage != "encoding" || item.DiscTitle != "Alien" {
+		t.Fatalf("FetchItem(7) = %+v, %v; want item 7", item, err)
+	}
+
+	_, err = c.FetchItem(context.Background(), 9)
+	if !errors.Is(err, ErrItemNotFound) {
+		t.Fatalf("FetchItem(9) error = %v, want ErrItemNotFound", err)
+	}
+
+	_, err = c.FetchItem(context.Background(), 8)
+	if err == nil || !strings.Contains(err.Error(), "decode response") {
+		t.Fatalf("FetchItem(8) error = %v, want decode response error", err)
+	}
+}
+
+func TestNewClient_TimeoutAndTransportOptions(t *testing.T) {
+	c, err := NewClient("127.0.0.1:1")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if c.http.Timeout != 5*time.Second {
+		t.Fatalf("default timeout = %v, want 5s", c.http.Timeout)
+	}
+
+	c, err = NewClient("127.0.0.1:1", WithTimeout(30*time.Second))
+	if err != nil || c.http.Timeout != 30*time.Second {
+		t.Fatalf("WithTimeout(30s) = %v, %v; want 30s", c.http.Timeout, err)
+	}
+
+	if _, err := NewClient("127.0.0.1:1", WithTimeout(-time.Second)); err == nil {
+		t.Fatal("NewClient with negative timeout returned nil error")
+	}
+}
+
+func TestClient_WithTransportIsUsed(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var calls atomic.Int32
+	c, err := NewClient(server.URL, WithTransport(countingTransport{&calls, http.DefaultTransport}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, err := c.FetchQueue(context.Background()); err != nil {
+		t.Fatalf("FetchQueue error = %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("custom transport saw %d requests, want 1", calls.Load())
+	}
+}
+
+func newTLSQueueServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[{"id":1}]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_TLSWithCACert(t *testing.T) {
+	t.Parallel()
+
+	server := newTLSQueueServer(t)
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caPath, certPEM, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	c, err := NewClient(server.URL, WithCACert(caPath))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	items, err := c.FetchQueue(context.Background())
+	if err != nil || len(items) != 1 {
+		t.Fatalf("FetchQueue = %v, %v; want 1 item over TLS", items, err)
+	}
+	want := systemCertPool()
+	want.AppendCertsFromPEM(certPEM)
+	if !c.tls.RootCAs.Equal(want) {
+		t.Fatal("WithCACert replaced the system roots, want them extended")
+	}
+
+	// Without the CA the server's self-signed cert is rejected.
+	plain, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, err := plain.FetchQueue(context.Background()); err == nil {
+		t.Fatal("FetchQueue without the CA succeeded, want certificate error")
+	}
+}
+
+func TestClient_TLSWithTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	server := newTLSQueueServer(t)
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	c, err := NewClient(server.URL, WithTLSConfig(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, err := c.FetchQueue(context.Background()); err != nil {
+		t.Fatalf("FetchQueue error = %v, want success with configured pool", err)
+	}
+}
+
+func TestNewClient_CACertErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewClient("https://example.test", WithCACert(filepath.Join(dir, "missing.pem"))); err == nil {
+		t.Fatal("NewClient with a missing CA file returned nil error")
+	}
+
+	bad := filepath.Join(dir, "bad.pem")
+	if err := os.WriteFile(bad, []byte("not a cert"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if _, err := NewClient("https://example.test", WithCACert(bad)); err == nil {
+		t.Fatal("NewClient with a CA file holding no certs returned nil error")
+	}
+}
+
+func TestClient_DecodesGzipResponses(t *testing.T) {
+	t.Parallel()
+
+	const queueJSON = `{"items":[{"id":1,"stage":"encoding","discTitle":"Alien"},{"id":2,"stage":"completed","discTitle":"Heat"}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want gzip", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		_, _ = zw.Write([]byte(queueJSON))
+		_ = zw.Close()
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	items, err := c.FetchQueue(context.Background())
+	if err != nil {
+		t.Fatalf("FetchQueue returned error: %v", err)
+	}
+	if len(items) != 2 || items[0].DiscTitle != "Alien" || items[1].Stage != "completed" {
+		t.Fatalf("FetchQueue = %+v, want two decoded items", items)
+	}
+}
+
+func TestClient_DecodesUncompressedResponses(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[{"id":3,"stage":"ripping","discTitle":"Ran"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	items, err := c.FetchQueue(context.Background())
+	if err != nil {
+		t.Fatalf("FetchQueue returned error: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != 3 || items[0].DiscTitle != "Ran" {
+		t.Fatalf("FetchQueue = %+v, want item 3", items)
+	}
+}
+
+func TestClient_GzipErrorBodyStaysRetryableAPIError(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway) // empty gzip body
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("not gzip"))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			zw := gzip.NewWriter(w)
+			_, _ = zw.Write([]byte(`{"error":"draining"}`))
+			_ = zw.Close()
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL, WithRetry(RetryOptions{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	_, err = c.FetchQueue(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("FetchQueue error = %v, want APIError 500", err)
+	}
+	if !strings.Contains(err.Error(), "draining") {
+		t.Fatalf("FetchQueue error = %v, want the gzipped error message", err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("requests = %d, want the empty and corrupt gzip 5xx bodies retried", got)
+	}
+}
+
+func TestClient_FetchQueueDelta(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/queue/delta" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.URL.Query().Get("since"); got != "2026-01-02T10:00:00Z" {
+			t.Errorf("since = %q, want RFC3339 cursor", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[{"id":7,"stage":"encoding"}],"serverTime":"2026-01-02T10:00:05Z"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	delta, err := c.FetchQueueDelta(context.Background(), since)
+	if err != nil {
+		t.Fatalf("FetchQueueDelta returned error: %v", err)
+	}
+	if len(delta.Items) != 1 || delta.Items[0].ID != 7 || !delta.ServerTime.Equal(since.Add(5*time.Second)) {
+		t.Fatalf("delta = %+v, want item 7 and server time +5s", delta)
+	}
+}
+
+func TestClient_FetchQueueDeltaUnsupported(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, err := c.FetchQueueDelta(context.Background(), time.Now()); !errors.Is(err, ErrQueueDeltaUnsupported) {
+		t.Fatalf("FetchQueueDelta error = %v, want ErrQueueDeltaUnsupported", err)
+	}
+}
+
+func TestClient_FetchDependencies(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/dependencies" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"dependencies":[{"name":"makemkvcon","available":true},{"name":"whisperx","optional":true,"detail":"not installed"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	deps, err := c.FetchDependencies(context.Background())
+	if err != nil {
+		t.Fatalf("FetchDependencies returned error: %v", err)
+	}
+	want := []DependencyStatus{
+		{Name: "makemkvcon", Available: true},
+		{Name: "whisperx", Optional: true, Detail: "not installed"},
+	}
+	if !slices.Equal(deps, want) {
+		t.Fatalf("FetchDependencies = %+v, want %+v", deps, want)
+	}
+}
+
+func TestClient_FetchDependenciesUnsupported(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, err := c.FetchDependencies(context.Background()); !errors.Is(err, ErrDependenciesUnsupported) {
+		t.Fatalf("FetchDependencies error = %v, want ErrDependenciesUnsupported", err)
+	}
+}
+
+// logTailServer serves /api/logs/tail over a five-line log, with offsets
+// counted in lines.
+func logTailServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	log := []string{"l0", "l1", "l2", "l3", "l4"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/logs/tail" {
+			http.NotFound(w, r)
+			return
+		}
+		q := r.URL.Query()
+		if got := q.Get("item"); got != "42" {
+			t.Errorf("item = %q, want 42", got)
+		}
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit := len(log)
+		if l := q.Get("limit"); l != "" {
+			limit, _ = strconv.Atoi(l)
+		}
+		if offset < 0 {
+			offset = max(len(log)-limit, 0)
+		}
+		offset = min(offset, len(log))
+		end := min(offset+limit, len(log))
+		_ = json.NewEncoder(w).Encode(LogTailBatch{Lines: log[offset:end], Offset: int64(end)})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_FetchLogTail(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClient(logTailServer(t).URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	ctx := context.Background()
+	cases := []struct {
+		name  string
+		query LogTailQuery
+		lines []string
+		next  int64
+	}{
+		{"from start", LogTailQuery{ItemID: 42, Offset: 0, Limit: 2}, []string{"l0", "l1"}, 2},
+		{"from offset", LogTailQuery{ItemID: 42, Offset: 2, Limit: 2}, []string{"l2", "l3"}, 4},
+		{"tail", LogTailQuery{ItemID: 42, Offset: -1, Limit: 2}, []string{"l3", "l4"}, 5},
+		{"caught up", LogTailQuery{ItemID: 42, Offset: 5, Limit: 2}, nil, 5},
+	}
+	for _, tc := range cases {
+		batch, err := c.FetchLogTail(ctx, tc.query)
+		if err != nil {
+			t.Fatalf("%s: FetchLogTail returned error: %v", tc.name, err)
+		}
+		if !slices.Equal(batch.Lines, tc.lines) || batch.Offset != tc.next {
+			t.Fatalf("%s: batch = %+v, want lines %q at offset %d", tc.name, batch, tc.lines, tc.next)
+		}
+	}
+}
+
+func TestClient_FetchLogTailUnsupported(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, err := c.FetchLogTail(context.Background(), LogTailQuery{Offset: -1}); !errors.Is(err, ErrLogTailUnsupported) {
+		t.Fatalf("FetchLogTail error = %v, want ErrLogTailUnsupported", err)
+	}
+}
+
+func TestClient_SetBaseURLSwitchesDaemon(t *testing.T) {
+	t.Parallel()
+
+	daemon := func(pid int) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("If-None-Match") == `"old"` {
+				t.Errorf("daemon %d got the previous daemon's ETag", pid)
+			}
+			w.Header().Set("ETag", `"old"`)
+			_ = json.NewEncoder(w).Encode(StatusResponse{PID: pid})
+		}))
+		t.Cleanup(server.Close)
+		return server
+	}
+	first, second := daemon(1), daemon(2)
+
+	c, err := NewClient(first.URL, WithETags())
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if status, err := c.FetchStatus(context.Background()); err != nil || status.PID != 1 {
+		t.Fatalf("FetchStatus = %+v, %v, want daemon 1", status, err)
+	}
+
+	if err := c.SetBaseURL(second.URL); err != nil {
+		t.Fatalf("SetBaseURL returned error: %v", err)
+	}
+	if status, err := c.FetchStatus(context.Background()); err != nil || status.PID != 2 {
+		t.Fatalf("FetchStatus after swap = %+v, %v, want daemon 2", status, err)
+	}
+	if err := c.SetBaseURL("  "); err == nil {
+		t.Fatal("SetBaseURL accepted an empty endpoint")
+	}
+}
+
+func TestLogQueryValues_TimeRange(t *testing.T) {
+	loc := time.FixedZone("EST", -5*3600)
+	q := LogQuery{
+		From: time.Date(2026, 5, 1, 14, 0, 0, 0, loc),
+		To:   time.Date(2026, 5, 1, 14, 30, 15, 0, loc),
+	}
+	values := q.Values()
+	if got := values.Get("since_ts"); got != "2026-05-01T19:00:00Z" {
+		t.Fatalf("since_ts = %q, want 2026-05-01T19:00:00Z", got)
+	}
+	if got := values.Get("until_ts"); got != "2026-05-01T19:30:15Z" {
+		t.Fatalf("until_ts = %q, want 2026-05-01T19:30:15Z", got)
+	}
+
+	if values := (LogQuery{Level: "warn"}).Values(); values.Has("since_ts") || values.Has("until_ts") {
+		t.Fatalf("zero time bounds encoded: %v", values)
+	}
+}
+
+func TestLogQueryValues_AllFields(t *testing.T) {
+	got := LogQuery{
+		Since:      7,
+		Limit:      13,
+		Tail:       true,
+		ItemID:     101,
+		Level:      " warn ",
+		Component:  "worker",
+		Lane:       "fast",
+		DaemonOnly: true,
+		Request:    "abc",
+	}.Values()
+	want := url.Values{
+		"since":       {"7"},
+		"limit":       {"13"},
+		"tail":        {"1"},
+		"item":        {"101"},
+		"level":       {"warn"},
+		"component":   {"worker"},
+		"lane":        {"fast"},
+		"daemon_only": {"1"},
+		"request":     {"abc"},
+	}
+	if got.Encode() != want.Encode() {
+		t.Fatalf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestLogQueryValues_EmptyOmitsEverything(t *testing.T) {
+	if got := (LogQuery{Level: "  ", Component: ""}).Values(); len(got) != 0 {
+		t.Fatalf("Values() = %v, want no params", got)
+	}
+}
+
+func TestLogQueryValues_ItemOnly(t *testing.T) {
+	if got := (LogQuery{ItemID: 42}).Values().Encode(); got != "item=42" {
+		t.Fatalf("Values().Encode() = %q, want item=42", got)
+	}
+}
+
+func TestFetchLogs_MinLevelFiltersWhenServerIgnoresIt(t *testing.T) {
+	t.Parallel()
+
+	var gotQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(LogBatch{
+			Events: []LogEvent{{Sequence: 1, Level: "info"}, {Sequence: 2, Level: "warn"}, {Sequence: 3, Level: "error"}},
+			Next:   3,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	batch, err := c.FetchLogs(context.Background(), LogQuery{MinLevel: "warn"})
+	if err != nil {
+		t.Fatalf("FetchLogs returned error: %v", err)
+	}
+	if gotQuery.Get("min_level") != "warn" || gotQuery.Has("level") {
+		t.Fatalf("query = %v, want min_level=warn and no exact level", gotQuery)
+	}
+	if len(batch.Events) != 2 || batch.Events[0].Sequence != 2 || batch.Events[1].Sequence != 3 {
+		t.Fatalf("events = %+v, want warn and error only", batch.Events)
+	}
+	if batch.Next != 3 {
+		t.Fatalf("Next = %d, want 3 (past the dropped event)", batch.Next)
+	}
+}
@@ -0,0 +1,103 @@
+package spindle
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrStreamUnavailable reports that the daemon has no /api/logs/stream
+// endpoint; callers fall back to polling FetchLogs.
+var ErrStreamUnavailable = errors.New("log stream unavailable")
+
+// StreamLogs follows /api/logs/stream, delivering each server-sent event as
+// a LogEvent in arrival order. Both channels close when the stream ends or
+// ctx is cancelled; at most one error is sent before closing, and none on
+// cancellation. A daemon without the endpoint yields ErrStreamUnavailable.
+func (c *Client) StreamLogs(ctx context.Context, query LogQuery) (<-chan LogEvent, <-chan error) {
+	events := make(chan LogEvent)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(events)
+		defer close(errs)
+		if err := c.streamLogs(ctx, query, events); err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+	return events, errs
+}
+
+// streamLogs connects to the stream and forwards events until it ends.
+func (c *Client) streamLogs(ctx context.Context, query LogQuery, events chan<- LogEvent) error {
+	if c == nil {
+		return fmt.Errorf("client is nil")
+	}
+	rel := &url.URL{Path: "/api/logs/stream", RawQuery: query.Values().Encode()}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL.Load().ResolveReference(rel).String(), nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("User-Agent", c.userAgent)
+	if token := c.token.Load(); token != nil && *token != "" {
+		req.Header.Set("Authorization", "Bearer "+*token)
+	}
+
+	// The stream is long-lived, so the per-request timeout does not apply;
+	// ctx bounds it instead.
+	hc := *c.http
+	hc.Timeout = 0
+	resp, err := hc.Do(req)
+	if err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrStreamUnavailable
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return apiStatusError(rel, resp)
+	}
+
+	// SSE frames are "field: value" lines ended by a blank line; data lines
+	// accumulate and comments (":") and other fields are ignored.
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	floor := LevelSeverity(query.MinLevel)
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			if value, ok := strings.CutPrefix(line, "data:"); ok {
+				data = append(data, strings.TrimPrefix(value, " "))
+			}
+			continue
+		}
+		if len(data) == 0 {
+			continue
+		}
+		var event LogEvent
+		if err := json.Unmarshal([]byte(strings.Join(data, "\n")), &event); err != nil {
+			return fmt.Errorf("decode log event: %w", err)
+		}
+		data = data[:0]
+		if floor > 0 && LevelSeverity(event.Level) < floor {
+			continue // below MinLevel on a daemon that ignores min_level
+		}
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read log stream: %w", err)
+	}
+	return nil
+}
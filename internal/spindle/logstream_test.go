@@ -0,0 +1,109 @@
+package spindle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_StreamLogsDeliversEventsInOrder(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/logs/stream" {
+			t.Errorf("path = %q, want /api/logs/stream", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("item"); got != "7" {
+			t.Errorf("item query = %q, want 7", got)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		flusher := w.(http.Flusher)
+		_, _ = fmt.Fprint(w, ": keepalive\n\n")
+		for seq := 1; seq <= 3; seq++ {
+			_, _ = fmt.Fprintf(w, "id: %d\nevent: log\ndata: {\"seq\":%d,\"msg\":\"line %d\",\"item_id\":7}\n\n", seq, seq, seq)
+			flusher.Flush()
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	events, errs := c.StreamLogs(context.Background(), LogQuery{ItemID: 7})
+
+	var got []LogEvent
+	for event := range events {
+		got = append(got, event)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("stream error: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d events, want 3", len(got))
+	}
+	for i, event := range got {
+		want := uint64(i + 1)
+		if event.Sequence != want || event.Message != fmt.Sprintf("line %d", want) || event.ItemID != 7 {
+			t.Fatalf("event %d = %+v, want seq %d", i, event, want)
+		}
+	}
+}
+
+func TestClient_StreamLogsUnavailable(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	events, errs := c.StreamLogs(context.Background(), LogQuery{})
+	for range events {
+		t.Fatal("unexpected event from a missing stream")
+	}
+	if err := <-errs; !errors.Is(err, ErrStreamUnavailable) {
+		t.Fatalf("stream error = %v, want ErrStreamUnavailable", err)
+	}
+}
+
+func TestClient_StreamLogsClosesOnCancel(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprint(w, "data: {\"seq\":1}\n\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs := c.StreamLogs(ctx, LogQuery{})
+	if event := <-events; event.Sequence != 1 {
+		t.Fatalf("first event = %+v, want seq 1", event)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatal("unexpected event after cancel")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("events channel not closed after cancel")
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("stream error after cancel = %v, want none", err)
+	}
+}
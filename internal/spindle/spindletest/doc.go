@@ -0,0 +1,3 @@
+// Package spindletest provides a scriptable spindle.StatusFetcher for
+// testing code that talks to the Spindle daemon without an HTTP server.
+package spindletest
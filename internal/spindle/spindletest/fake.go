@@ -0,0 +1,187 @@
+package spindletest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// Ensure FakeClient implements StatusFetcher at compile time.
+var _ spindle.StatusFetcher = (*FakeClient)(nil)
+
+// result is one scripted response.
+type result[T any] struct {
+	value T
+	err   error
+}
+
+// script hands out scripted responses in order, repeating the last one
+// once the rest are used up.
+type script[T any] struct {
+	results []result[T]
+	next    int
+}
+
+func (s *script[T]) push(value T, err error) {
+	s.results = append(s.results, result[T]{value: value, err: err})
+}
+
+func (s *script[T]) pop() (result[T], bool) {
+	if len(s.results) == 0 {
+		return result[T]{}, false
+	}
+	r := s.results[s.next]
+	if s.next < len(s.results)-1 {
+		s.next++
+	}
+	return r, true
+}
+
+// FakeClient is a spindle.StatusFetcher whose responses are scripted per
+// method. Each method returns its scripted responses in order and repeats
+// the last once exhausted; an unscripted method returns an error, except
+// FetchQueueDelta and FetchDependencies, which report
+// spindle.ErrQueueDeltaUnsupported and spindle.ErrDependenciesUnsupported
+// like a daemon without those endpoints. The zero value is ready to use and safe
+// for concurrent calls.
+type FakeClient struct {
+	mu     sync.Mutex
+	status script[*spindle.StatusResponse]
+	queue  script[[]spindle.QueueItem]
+	deltas script[spindle.QueueDelta]
+	logs   script[spindle.LogBatch]
+	deps   script[[]spindle.DependencyStatus]
+
+	calls      map[string]int
+	logQueries []spindle.LogQuery
+}
+
+// ScriptStatus appends a FetchStatus response.
+func (f *FakeClient) ScriptStatus(status *spindle.StatusResponse, err error) *FakeClient {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status.push(status, err)
+	return f
+}
+
+// ScriptQueue appends a FetchQueue response.
+func (f *FakeClient) ScriptQueue(items []spindle.QueueItem, err error) *FakeClient {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queue.push(items, err)
+	return f
+}
+
+// ScriptQueueDelta appends a FetchQueueDelta response.
+func (f *FakeClient) ScriptQueueDelta(delta spindle.QueueDelta, err error) *FakeClient {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deltas.push(delta, err)
+	return f
+}
+
+// ScriptDependencies appends a FetchDependencies response.
+func (f *FakeClient) ScriptDependencies(deps []spindle.DependencyStatus, err error) *FakeClient {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deps.push(deps, err)
+	return f
+}
+
+// ScriptLogs appends a FetchLogs response.
+func (f *FakeClient) ScriptLogs(batch spindle.LogBatch, err error) *FakeClient {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.logs.push(batch, err)
+	return f
+}
+
+// Calls returns how many times the named method (e.g. "FetchStatus") was
+// called.
+func (f *FakeClient) Calls(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[method]
+}
+
+// LogQueries returns the queries FetchLogs received, oldest first.
+func (f *FakeClient) LogQueries() []spindle.LogQuery {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]spindle.LogQuery(nil), f.logQueries...)
+}
+
+// FetchStatus returns the next scripted status.
+func (f *FakeClient) FetchStatus(ctx context.Context) (*spindle.StatusResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("FetchStatus")
+	r, ok := f.status.pop()
+	if !ok {
+		return nil, unscripted("FetchStatus")
+	}
+	return r.value, r.err
+}
+
+// FetchQueue returns the next scripted queue.
+func (f *FakeClient) FetchQueue(ctx context.Context) ([]spindle.QueueItem, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("FetchQueue")
+	r, ok := f.queue.pop()
+	if !ok {
+		return nil, unscripted("FetchQueue")
+	}
+	return r.value, r.err
+}
+
+// FetchQueueDelta returns the next scripted delta.
+func (f *FakeClient) FetchQueueDelta(ctx context.Context, since time.Time) (spindle.QueueDelta, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("FetchQueueDelta")
+	r, ok := f.deltas.pop()
+	if !ok {
+		return spindle.QueueDelta{}, spindle.ErrQueueDeltaUnsupported
+	}
+	return r.value, r.err
+}
+
+// FetchLogs records query and returns the next scripted batch.
+func (f *FakeClient) FetchLogs(ctx context.Context, query spindle.LogQuery) (spindle.LogBatch, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("FetchLogs")
+	f.logQueries = append(f.logQueries, query)
+	r, ok := f.logs.pop()
+	if !ok {
+		return spindle.LogBatch{}, unscripted("FetchLogs")
+	}
+	return r.value, r.err
+}
+
+// FetchDependencies returns the next scripted dependency list.
+func (f *FakeClient) FetchDependencies(ctx context.Context) ([]spindle.DependencyStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("FetchDependencies")
+	r, ok := f.deps.pop()
+	if !ok {
+		return nil, spindle.ErrDependenciesUnsupported
+	}
+	return r.value, r.err
+}
+
+func (f *FakeClient) record(method string) {
+	if f.calls == nil {
+		f.calls = make(map[string]int)
+	}
+	f.calls[method]++
+}
+
+func unscripted(method string) error {
+	return fmt.Errorf("spindletest: %s not scripted", method)
+}
@@ -2,6 +2,9 @@ package spindle
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -67,6 +70,11 @@ type DependencyStatus struct {
 	Detail    string `json:"detail"`
 }
 
+// DependenciesResponse mirrors /api/dependencies.
+type DependenciesResponse struct {
+	Dependencies []DependencyStatus `json:"dependencies"`
+}
+
 // QueueListResponse mirrors /api/queue.
 type QueueListResponse struct {
 	Items []QueueItem `json:"items"`
@@ -121,6 +129,24 @@ type TaskProgress struct {
 	TotalBytes  int64   `json:"totalBytes"`
 }
 
+// PercentDone returns the reported percent, falling back to the byte
+// counts when the task reports bytes but no percent.
+func (p TaskProgress) PercentDone() float64 {
+	if p.Percent > 0 || p.TotalBytes <= 0 {
+		return p.Percent
+	}
+	return p.BytePercent()
+}
+
+// BytePercent returns the byte-copy progress, 0-100, or 0 when the task
+// reports no byte total.
+func (p TaskProgress) BytePercent() float64 {
+	if p.TotalBytes <= 0 {
+		return 0
+	}
+	return min(max(float64(p.BytesCopied)/float64(p.TotalBytes)*100, 0), 100)
+}
+
 // Task state helpers.
 func (t Task) IsRunning() bool { return t.State == "running" }
 func (t Task) IsDone() bool    { return t.State == "done" }
@@ -264,6 +290,20 @@ type EncodingIssue struct {
 	Suggestion string `json:"suggestion,omitempty"`
 }
 
+// OverallPercent returns the encode percent: the reported percent when
+// positive, else derived from the frame counts, else 0, clamped to
+// [0, 100].
+func (e *EncodingStatus) OverallPercent() float64 {
+	if e == nil {
+		return 0
+	}
+	pct := e.Percent
+	if pct <= 0 && e.TotalFrames > 0 {
+		pct = float64(e.CurrentFrame) / float64(e.TotalFrames) * 100
+	}
+	return min(max(pct, 0), 100)
+}
+
 // ETADuration returns the ETA as a duration when available.
 func (e *EncodingStatus) ETADuration() time.Duration {
 	if e == nil || e.ETASeconds <= 0 {
@@ -272,6 +312,35 @@ func (e *EncodingStatus) ETADuration() time.Duration {
 	return time.Duration(e.ETASeconds * float64(time.Second))
 }
 
+// EncodeDuration returns the finished encode's wall-clock time when
+// reported.
+func (e *EncodingStatus) EncodeDuration() time.Duration {
+	if e == nil || e.EncodeDurationSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(e.EncodeDurationSeconds * float64(time.Second))
+}
+
+// MediaDuration returns the playback length of the item's main title, or
+// zero when the source runtime is unknown.
+func (q QueueItem) MediaDuration() time.Duration {
+	if q.Source == nil || q.Source.DurationSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(q.Source.DurationSeconds) * time.Second
+}
+
+// RealtimeFactor compares media duration to encode wall-clock time for a
+// completed encode: 2.0 means the encode ran twice as fast as playback.
+// Zero when either duration is unknown.
+func (q QueueItem) RealtimeFactor() float64 {
+	media, encode := q.MediaDuration(), q.Encoding.EncodeDuration()
+	if media <= 0 || encode <= 0 {
+		return 0
+	}
+	return media.Seconds() / encode.Seconds()
+}
+
 // EpisodeStatus is spindle's per-episode projection. Stage reflects asset
 // completion (planned/ripped/encoded/subtitled/final), not the pipeline.
 type EpisodeStatus struct {
@@ -306,12 +375,32 @@ type EpisodeStatus struct {
 	ReviewReason         string   `json:"reviewReason"`
 }
 
-// IsFailed returns true if the episode has a failed status.
+// IsFailed returns true if the episode has a failed status or reports an
+// error.
 func (e EpisodeStatus) IsFailed() bool {
-	return strings.EqualFold(strings.TrimSpace(e.Status), "failed")
+	return strings.EqualFold(strings.TrimSpace(e.Status), "failed") || strings.TrimSpace(e.ErrorMessage) != ""
+}
+
+// ProgressPercent returns the episode's baseline progress from its stage:
+// planned 0, ripped 25, encoded 50, subtitled 75, final 100. Unknown
+// stages count as 0.
+func (e EpisodeStatus) ProgressPercent() float64 {
+	switch strings.ToLower(strings.TrimSpace(e.Stage)) {
+	case "ripped":
+		return 25
+	case "encoded":
+		return 50
+	case "subtitled":
+		return 75
+	case "final":
+		return 100
+	default:
+		return 0
+	}
 }
 
-// FilterFailed returns episodes with failed status from the given slice.
+// FilterFailed returns the failed episodes (see IsFailed) from the given
+// slice.
 func FilterFailed(episodes []EpisodeStatus) []EpisodeStatus {
 	var failed []EpisodeStatus
 	for _, ep := range episodes {
@@ -360,6 +449,41 @@ func (e LogEvent) ParsedTime() time.Time {
 	return parseTime(e.Timestamp)
 }
 
+// LevelSeverity ranks a log level on the canonical DEBUG < INFO < WARN <
+// ERROR < FATAL scale as 1-5, accepting common aliases (warning, err,
+// critical, panic) in any case. Unknown and empty levels rank 0.
+func LevelSeverity(level string) int {
+	switch strings.ToUpper(strings.TrimSpace(level)) {
+	case "DEBUG", "TRACE":
+		return 1
+	case "INFO":
+		return 2
+	case "WARN", "WARNING":
+		return 3
+	case "ERROR", "ERR":
+		return 4
+	case "FATAL", "CRIT", "CRITICAL", "PANIC":
+		return 5
+	}
+	return 0
+}
+
+// FilterMinLevel returns the events whose level is at or above min. An
+// empty or unknown min keeps every event.
+func FilterMinLevel(events []LogEvent, min string) []LogEvent {
+	floor := LevelSeverity(min)
+	if floor == 0 {
+		return events
+	}
+	kept := events[:0:0]
+	for _, evt := range events {
+		if LevelSeverity(evt.Level) >= floor {
+			kept = append(kept, evt)
+		}
+	}
+	return kept
+}
+
 // LogBatch aggregates a slice of log events with the next sequence cursor.
 type LogBatch struct {
 	Events []LogEvent `json:"events"`
@@ -376,6 +500,192 @@ func (q QueueItem) ParsedUpdatedAt() time.Time {
 	return parseTime(q.UpdatedAt)
 }
 
+// Clone returns a deep copy of the item: slices, raw metadata and pointed-to
+// structs are copied so the clone shares no mutable state with q.
+func (q QueueItem) Clone() QueueItem {
+	c := q
+	c.ReviewReasons = slices.Clone(q.ReviewReasons)
+	c.Metadata = slices.Clone(q.Metadata)
+	if q.Tasks != nil {
+		c.Tasks = make([]Task, len(q.Tasks))
+		for i, t := range q.Tasks {
+			t.DependsOn = slices.Clone(t.DependsOn)
+			c.Tasks[i] = t
+		}
+	}
+	if q.Episodes != nil {
+		c.Episodes = make([]EpisodeStatus, len(q.Episodes))
+		for i, ep := range q.Episodes {
+			ep.SubtitleReviewIssues = slices.Clone(ep.SubtitleReviewIssues)
+			ep.SubtitleSevereIssues = slices.Clone(ep.SubtitleSevereIssues)
+			c.Episodes[i] = ep
+		}
+	}
+	if q.Encoding != nil {
+		enc := *q.Encoding
+		if enc.Error != nil {
+			issue := *enc.Error
+			enc.Error = &issue
+		}
+		if enc.Validation != nil {
+			v := *enc.Validation
+			v.Steps = slices.Clone(v.Steps)
+			enc.Validation = &v
+		}
+		c.Encoding = &enc
+	}
+	if q.ContentID != nil {
+		id := *q.ContentID
+		c.ContentID = &id
+	}
+	if q.Source != nil {
+		src := *q.Source
+		c.Source = &src
+	}
+	return c
+}
+
+// Metadata is the typed view of QueueItem.Metadata. Fields the payload
+// omits are zero; keys without a field are kept in Extra.
+type Metadata struct {
+	MediaType    string // lowercase "movie" or "tv" (from media_type, else type)
+	Title        string
+	Year         string // spindle sends a string; a number is tolerated
+	SeasonNumber int
+	TMDBID       int64 // the "id" key
+	Overview     string
+	Extra        map[string]any
+}
+
+// ParseMetadata decodes the item's metadata JSON. Empty metadata yields the
+// zero value; malformed JSON an error.
+func (q QueueItem) ParseMetadata() (Metadata, error) {
+	var meta Metadata
+	if len(q.Metadata) == 0 {
+		return meta, nil
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(q.Metadata, &obj); err != nil {
+		return Metadata{}, fmt.Errorf("parse metadata: %w", err)
+	}
+	for key, val := range obj {
+		switch key {
+		case "media_type":
+			meta.MediaType = strings.ToLower(metadataString(val))
+		case "title":
+			meta.Title = metadataString(val)
+		case "year":
+			meta.Year = metadataString(val)
+		case "season_number":
+			meta.SeasonNumber = int(metadataInt(val))
+		case "id":
+			meta.TMDBID = metadataInt(val)
+		case "overview":
+			meta.Overview = metadataString(val)
+		default:
+			if key == "type" && meta.MediaType == "" {
+				if _, ok := obj["media_type"]; !ok {
+					meta.MediaType = strings.ToLower(metadataString(val))
+					continue
+				}
+			}
+			if meta.Extra == nil {
+				meta.Extra = make(map[string]any)
+			}
+			meta.Extra[key] = val
+		}
+	}
+	return meta, nil
+}
+
+// FullTitle returns a richer label than DisplayTitle alone: movies gain
+// their year ("Alien (1979)") and TV items their season and episode count
+// ("Show - S01 (6 eps)"). Without usable metadata it falls back to
+// DisplayTitle, then DiscTitle, then "Item #ID". (A DisplayTitle method
+// would collide with the DisplayTitle field.)
+func (q QueueItem) FullTitle() string {
+	title := q.DisplayTitle
+	if title == "" {
+		title = q.DiscTitle
+	}
+	meta, err := q.ParseMetadata()
+	if err == nil && meta.Title != "" && title == "" {
+		title = meta.Title
+	}
+	if title == "" {
+		return fmt.Sprintf("Item #%d", q.ID)
+	}
+	if err != nil {
+		return title
+	}
+
+	switch meta.MediaType {
+	case "movie":
+		if meta.Year != "" && !strings.Contains(title, meta.Year) {
+			title += " (" + meta.Year + ")"
+		}
+	case "tv":
+		var parts []string
+		if meta.SeasonNumber > 0 && !strings.Contains(title, fmt.Sprintf("S%02d", meta.SeasonNumber)) {
+			parts = append(parts, fmt.Sprintf("S%02d", meta.SeasonNumber))
+		}
+		switch n := len(q.Episodes); {
+		case n == 1:
+			parts = append(parts, "(1 ep)")
+		case n > 1:
+			parts = append(parts, fmt.Sprintf("(%d eps)", n))
+		}
+		if len(parts) > 0 {
+			title += " - " + strings.Join(parts, " ")
+		}
+	}
+	return title
+}
+
+// metadataString returns a trimmed string value, formatting numbers.
+func metadataString(val any) string {
+	switch v := val.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+// metadataInt returns an integer value given as a number or numeric string.
+func metadataInt(val any) int64 {
+	switch v := val.(type) {
+	case float64:
+		return int64(v)
+	case string:
+		n, _ := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		return n
+	}
+	return 0
+}
+
+// Elapsed returns the time since the item was created, zero when the
+// creation time is unknown.
+func (q QueueItem) Elapsed() time.Duration {
+	created := q.ParsedCreatedAt()
+	if created.IsZero() {
+		return 0
+	}
+	return time.Since(created)
+}
+
+// IsStalled reports whether the item has running work but has not been
+// updated for longer than threshold. An unknown update time never counts
+// as stalled.
+func (q QueueItem) IsStalled(threshold time.Duration) bool {
+	if len(q.RunningTasks()) == 0 {
+		return false
+	}
+	updated := q.ParsedUpdatedAt()
+	return !updated.IsZero() && time.Since(updated) > threshold
+}
+
 func parseTime(value string) time.Time {
 	if value == "" {
 		return time.Time{}
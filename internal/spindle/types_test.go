@@ -1,6 +1,8 @@
 package spindle
 
 import (
+	"encoding/json"
+	"slices"
 	"testing"
 	"time"
 )
@@ -109,3 +111,346 @@ func TestIsTerminal(t *testing.T) {
 		t.Fatalf("failed should be terminal")
 	}
 }
+
+func TestEpisodeStatus_ProgressPercent(t *testing.T) {
+	for stage, want := range map[string]float64{
+		"planned":   0,
+		"ripped":    25,
+		"Encoded":   50,
+		"subtitled": 75,
+		" final ":   100,
+		"":          0,
+		"mystery":   0,
+	} {
+		if got := (EpisodeStatus{Stage: stage}).ProgressPercent(); got != want {
+			t.Errorf("ProgressPercent(%q) = %v, want %v", stage, got, want)
+		}
+	}
+}
+
+func TestEpisodeStatus_IsFailed(t *testing.T) {
+	cases := []struct {
+		name string
+		ep   EpisodeStatus
+		want bool
+	}{
+		{"pending", EpisodeStatus{Status: "pending"}, false},
+		{"failed status", EpisodeStatus{Status: " Failed "}, true},
+		{"error message", EpisodeStatus{Status: "pending", ErrorMessage: "encode crashed"}, true},
+		{"blank error", EpisodeStatus{ErrorMessage: "  "}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.ep.IsFailed(); got != tc.want {
+			t.Errorf("%s: IsFailed() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestFilterFailed(t *testing.T) {
+	episodes := []EpisodeStatus{
+		{Key: "s01e01", Status: "completed"},
+		{Key: "s01e02", Status: "failed"},
+		{Key: "s01e03", ErrorMessage: "rip error"},
+	}
+	var keys []string
+	for _, ep := range FilterFailed(episodes) {
+		keys = append(keys, ep.Key)
+	}
+	if want := []string{"s01e02", "s01e03"}; !slices.Equal(keys, want) {
+		t.Fatalf("FilterFailed() = %v, want %v", keys, want)
+	}
+	if got := FilterFailed(nil); got != nil {
+		t.Fatalf("FilterFailed(nil) = %v, want nil", got)
+	}
+}
+
+func TestEpisodeStatus_DecodesStatusFields(t *testing.T) {
+	var ep EpisodeStatus
+	data := `{"key":"s01e02","stage":"ripped","status":"failed","errorMessage":"encode crashed","active":true}`
+	if err := json.Unmarshal([]byte(data), &ep); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !ep.Active || ep.ErrorMessage != "encode crashed" || !ep.IsFailed() {
+		t.Fatalf("decoded episode = %+v", ep)
+	}
+}
+
+func TestRealtimeFactor(t *testing.T) {
+	item := QueueItem{
+		Source:   &SourceTitle{DurationSeconds: 7200},
+		Encoding: &EncodingStatus{EncodeDurationSeconds: 3600},
+	}
+	if got := item.RealtimeFactor(); got != 2 {
+		t.Fatalf("RealtimeFactor() = %v, want 2", got)
+	}
+	if got := item.Encoding.EncodeDuration(); got != time.Hour {
+		t.Fatalf("EncodeDuration() = %v, want 1h", got)
+	}
+
+	for name, item := range map[string]QueueItem{
+		"no source":   {Encoding: &EncodingStatus{EncodeDurationSeconds: 3600}},
+		"no encoding": {Source: &SourceTitle{DurationSeconds: 7200}},
+		"unfinished":  {Source: &SourceTitle{DurationSeconds: 7200}, Encoding: &EncodingStatus{Percent: 40}},
+	} {
+		if got := item.RealtimeFactor(); got != 0 {
+			t.Fatalf("%s: RealtimeFactor() = %v, want 0", name, got)
+		}
+	}
+}
+
+func TestTaskProgressPercentDone(t *testing.T) {
+	cases := []struct {
+		name string
+		p    TaskProgress
+		want float64
+	}{
+		{"percent only", TaskProgress{Percent: 40}, 40},
+		{"bytes only", TaskProgress{BytesCopied: 1 << 30, TotalBytes: 4 << 30}, 25},
+		{"both prefers percent", TaskProgress{Percent: 60, BytesCopied: 1 << 30, TotalBytes: 4 << 30}, 60},
+		{"neither", TaskProgress{}, 0},
+	}
+	for _, tc := range cases {
+		if got := tc.p.PercentDone(); got != tc.want {
+			t.Fatalf("%s: PercentDone() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestTaskProgressBytePercent(t *testing.T) {
+	cases := []struct {
+		name string
+		p    TaskProgress
+		want float64
+	}{
+		{"zero", TaskProgress{}, 0},
+		{"copied without total", TaskProgress{BytesCopied: 1 << 30}, 0},
+		{"nothing copied", TaskProgress{TotalBytes: 4 << 30}, 0},
+		{"partial", TaskProgress{BytesCopied: 1 << 30, TotalBytes: 4 << 30}, 25},
+		{"overshoot", TaskProgress{BytesCopied: 5 << 30, TotalBytes: 4 << 30}, 100},
+	}
+	for _, tc := range cases {
+		if got := tc.p.BytePercent(); got != tc.want {
+			t.Fatalf("%s: BytePercent() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestTaskProgress_DecodesByteFields(t *testing.T) {
+	var task Task
+	data := `{"type":"organizing","state":"running","progress":{"percent":0,"message":"copying","bytesCopied":3221225472,"totalBytes":4294967296}}`
+	if err := json.Unmarshal([]byte(data), &task); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if task.Progress.BytesCopied != 3<<30 || task.Progress.TotalBytes != 4<<30 {
+		t.Fatalf("Progress = %+v, want 3GiB of 4GiB", task.Progress)
+	}
+	if got := task.Progress.BytePercent(); got != 75 {
+		t.Fatalf("BytePercent() = %v, want 75", got)
+	}
+}
+
+func TestQueueItemClone_SharesNothing(t *testing.T) {
+	orig := QueueItem{
+		ID:        1,
+		Metadata:  json.RawMessage(`{"title":"Heat"}`),
+		Tasks:     []Task{{Type: "encoding", DependsOn: []string{"ripping"}}},
+		Episodes:  []EpisodeStatus{{Key: "s01e01", SubtitleSevereIssues: []string{"missing"}}},
+		Encoding:  &EncodingStatus{Validation: &EncodingValidation{Steps: []EncodingValidationStep{{}}}},
+		ContentID: &ContentID{},
+		Source:    &SourceTitle{TitleID: 2},
+	}
+	c := orig.Clone()
+	c.Metadata[0] = 'X'
+	c.Tasks[0].DependsOn[0] = "mutated"
+	c.Episodes[0].SubtitleSevereIssues[0] = "mutated"
+	c.Encoding.Validation.Steps = nil
+	c.Source.TitleID = 9
+
+	if string(orig.Metadata) != `{"title":"Heat"}` || orig.Tasks[0].DependsOn[0] != "ripping" ||
+		orig.Episodes[0].SubtitleSevereIssues[0] != "missing" || len(orig.Encoding.Validation.Steps) != 1 ||
+		orig.Source.TitleID != 2 || c.ContentID == orig.ContentID {
+		t.Fatalf("Clone shares state with the original: %+v", orig)
+	}
+	if empty := (QueueItem{}).Clone(); empty.Tasks != nil || empty.Metadata != nil || empty.Encoding != nil {
+		t.Fatalf("Clone of an empty item = %+v, want nil fields kept nil", empty)
+	}
+}
+
+func TestQueueItemElapsed(t *testing.T) {
+	if got := (QueueItem{}).Elapsed(); got != 0 {
+		t.Fatalf("Elapsed with no createdAt = %v, want 0", got)
+	}
+	created := time.Now().Add(-90 * time.Minute).UTC().Format(time.RFC3339)
+	got := QueueItem{CreatedAt: created}.Elapsed()
+	if got < 89*time.Minute || got > 91*time.Minute {
+		t.Fatalf("Elapsed = %v, want about 90m", got)
+	}
+}
+
+func TestQueueItemIsStalled(t *testing.T) {
+	ago := func(d time.Duration) string { return time.Now().Add(-d).UTC().Format(time.RFC3339) }
+	running := []Task{{Type: "encoding", State: "running"}}
+	cases := []struct {
+		name string
+		item QueueItem
+		want bool
+	}{
+		{"zero updatedAt", QueueItem{Stage: "encoding", Tasks: running}, false},
+		{"fresh", QueueItem{Stage: "encoding", Tasks: running, UpdatedAt: ago(time.Minute)}, false},
+		{"stalled", QueueItem{Stage: "encoding", Tasks: running, UpdatedAt: ago(15 * time.Minute)}, true},
+		{"idle not processing", QueueItem{Stage: "pending", UpdatedAt: ago(time.Hour)}, false},
+		{"completed", QueueItem{Stage: "completed", Tasks: []Task{{Type: "encoding", State: "done"}}, UpdatedAt: ago(time.Hour)}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.item.IsStalled(10 * time.Minute); got != tc.want {
+			t.Errorf("%s: IsStalled = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestParseMetadata_Movie(t *testing.T) {
+	item := QueueItem{Metadata: []byte(`{"id":603,"title":"The Matrix","year":"1999","media_type":"Movie","overview":"A hacker learns the truth.","vote_average":8.2,"movie":true}`)}
+	meta, err := item.ParseMetadata()
+	if err != nil {
+		t.Fatalf("ParseMetadata returned error: %v", err)
+	}
+	if meta.MediaType != "movie" || meta.Title != "The Matrix" || meta.Year != "1999" || meta.TMDBID != 603 || meta.Overview == "" {
+		t.Fatalf("meta = %+v, want typed movie fields", meta)
+	}
+	if meta.SeasonNumber != 0 {
+		t.Fatalf("SeasonNumber = %d, want 0 for a movie", meta.SeasonNumber)
+	}
+	if meta.Extra["vote_average"] != 8.2 || meta.Extra["movie"] != true || len(meta.Extra) != 2 {
+		t.Fatalf("Extra = %v, want vote_average and movie only", meta.Extra)
+	}
+}
+
+func TestParseMetadata_TV(t *testing.T) {
+	item := QueueItem{Metadata: []byte(`{"id":"1399","show_title":"Game of Thrones","type":"tv","season_number":3,"year":2013}`)}
+	meta, err := item.ParseMetadata()
+	if err != nil {
+		t.Fatalf("ParseMetadata returned error: %v", err)
+	}
+	if meta.MediaType != "tv" || meta.SeasonNumber != 3 || meta.TMDBID != 1399 || meta.Year != "2013" {
+		t.Fatalf("meta = %+v, want typed tv fields", meta)
+	}
+	if meta.Title != "" || meta.Extra["show_title"] != "Game of Thrones" {
+		t.Fatalf("meta = %+v, want show_title kept in Extra", meta)
+	}
+}
+
+func TestFullTitle_MovieWithYear(t *testing.T) {
+	item := QueueItem{DisplayTitle: "Alien", Metadata: []byte(`{"title":"Alien","year":"1979","media_type":"movie"}`)}
+	if got := item.FullTitle(); got != "Alien (1979)" {
+		t.Fatalf("FullTitle() = %q, want %q", got, "Alien (1979)")
+	}
+	item.DisplayTitle = "Alien (1979)"
+	if got := item.FullTitle(); got != "Alien (1979)" {
+		t.Fatalf("FullTitle() = %q, want the year once", got)
+	}
+}
+
+func TestFullTitle_TVWithEpisodeCount(t *testing.T) {
+	item := QueueItem{
+		DiscTitle: "The Office",
+		Metadata:  []byte(`{"type":"tv","season_number":1}`),
+		Episodes:  make([]EpisodeStatus, 6),
+	}
+	if got := item.FullTitle(); got != "The Office - S01 (6 eps)" {
+		t.Fatalf("FullTitle() = %q, want %q", got, "The Office - S01 (6 eps)")
+	}
+	item.Episodes = item.Episodes[:1]
+	if got := item.FullTitle(); got != "The Office - S01 (1 ep)" {
+		t.Fatalf("FullTitle() = %q, want %q", got, "The Office - S01 (1 ep)")
+	}
+}
+
+func TestFullTitle_NoMetadataFallback(t *testing.T) {
+	tests := []struct {
+		item QueueItem
+		want string
+	}{
+		{QueueItem{ID: 4, DisplayTitle: "Heat", DiscTitle: "HEAT_DISC1"}, "Heat"},
+		{QueueItem{ID: 4, DiscTitle: "HEAT_DISC1"}, "HEAT_DISC1"},
+		{QueueItem{ID: 4}, "Item #4"},
+		{QueueItem{ID: 4, DiscTitle: "Heat", Metadata: []byte(`{not-json`)}, "Heat"},
+		{QueueItem{ID: 4, Metadata: []byte(`{"title":"Heat","year":"1995","media_type":"movie"}`)}, "Heat (1995)"},
+	}
+	for _, tt := range tests {
+		if got := tt.item.FullTitle(); got != tt.want {
+			t.Errorf("FullTitle(%+v) = %q, want %q", tt.item, got, tt.want)
+		}
+	}
+}
+
+func TestParseMetadata_EmptyAndInvalid(t *testing.T) {
+	meta, err := QueueItem{}.ParseMetadata()
+	if err != nil || meta.MediaType != "" || meta.Extra != nil {
+		t.Fatalf("empty metadata = %+v, %v; want zero value", meta, err)
+	}
+	if _, err := (QueueItem{Metadata: []byte(`{not-json`)}).ParseMetadata(); err == nil {
+		t.Fatalf("invalid metadata should return an error")
+	}
+	if _, err := (QueueItem{Metadata: []byte(`[1,2]`)}).ParseMetadata(); err == nil {
+		t.Fatalf("non-object metadata should return an error")
+	}
+}
+
+func TestEncodingOverallPercent(t *testing.T) {
+	cases := []struct {
+		name string
+		enc  *EncodingStatus
+		want float64
+	}{
+		{"nil", nil, 0},
+		{"zero", &EncodingStatus{}, 0},
+		{"explicit percent", &EncodingStatus{Percent: 42, CurrentFrame: 10, TotalFrames: 100}, 42},
+		{"frame derived", &EncodingStatus{CurrentFrame: 250, TotalFrames: 1000}, 25},
+		{"clamped", &EncodingStatus{CurrentFrame: 1200, TotalFrames: 1000}, 100},
+	}
+	for _, tc := range cases {
+		if got := tc.enc.OverallPercent(); got != tc.want {
+			t.Errorf("%s: OverallPercent = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestLevelSeverity_Ordering(t *testing.T) {
+	order := []string{"debug", "INFO", "Warning", "err", "critical"}
+	for i := 1; i < len(order); i++ {
+		if LevelSeverity(order[i-1]) >= LevelSeverity(order[i]) {
+			t.Fatalf("LevelSeverity(%q) >= LevelSeverity(%q), want ascending", order[i-1], order[i])
+		}
+	}
+	if got := LevelSeverity("warn"); got != LevelSeverity("WARNING") {
+		t.Fatalf("warn and WARNING rank differently")
+	}
+	if got := LevelSeverity("verbose"); got != 0 {
+		t.Fatalf("LevelSeverity(unknown) = %d, want 0", got)
+	}
+}
+
+func TestFilterMinLevel(t *testing.T) {
+	events := []LogEvent{
+		{Sequence: 1, Level: "debug"},
+		{Sequence: 2, Level: "info"},
+		{Sequence: 3, Level: "warn"},
+		{Sequence: 4, Level: "error"},
+		{Sequence: 5, Level: "fatal"},
+		{Sequence: 6, Level: ""},
+	}
+
+	var seqs []uint64
+	for _, evt := range FilterMinLevel(events, "WARN") {
+		seqs = append(seqs, evt.Sequence)
+	}
+	if want := []uint64{3, 4, 5}; !slices.Equal(seqs, want) {
+		t.Fatalf("FilterMinLevel(warn) = %v, want %v", seqs, want)
+	}
+	if got := FilterMinLevel(events, ""); len(got) != len(events) {
+		t.Fatalf("FilterMinLevel(\"\") kept %d events, want all %d", len(got), len(events))
+	}
+	if len(events) != 6 || events[0].Sequence != 1 {
+		t.Fatalf("FilterMinLevel modified its input: %v", events)
+	}
+}
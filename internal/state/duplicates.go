@@ -0,0 +1,36 @@
+package state
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// DuplicateDiscs maps each item whose DiscFingerprint another item shares
+// to the IDs of those other items, ascending. Fingerprints compare
+// case-insensitively after trimming; items without one never match.
+// Items with a unique fingerprint are absent.
+func DuplicateDiscs(queue []spindle.QueueItem) map[int64][]int64 {
+	byPrint := make(map[string][]int64)
+	for _, item := range queue {
+		if fp := strings.ToLower(strings.TrimSpace(item.DiscFingerprint)); fp != "" {
+			byPrint[fp] = append(byPrint[fp], item.ID)
+		}
+	}
+	dups := make(map[int64][]int64)
+	for _, ids := range byPrint {
+		if len(ids) < 2 {
+			continue
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		for _, id := range ids {
+			for _, other := range ids {
+				if other != id {
+					dups[id] = append(dups[id], other)
+				}
+			}
+		}
+	}
+	return dups
+}
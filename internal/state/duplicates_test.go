@@ -0,0 +1,41 @@
+package state
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+func TestDuplicateDiscs(t *testing.T) {
+	queue := []spindle.QueueItem{
+		{ID: 7, DiscFingerprint: "ABC123"},
+		{ID: 2, DiscFingerprint: "abc123 "},
+		{ID: 4, DiscFingerprint: "ffee00"},
+		{ID: 9, DiscFingerprint: "abc123"},
+		{ID: 5},
+		{ID: 6, DiscFingerprint: "  "},
+	}
+	dups := DuplicateDiscs(queue)
+
+	want := map[int64][]int64{
+		2: {7, 9},
+		7: {2, 9},
+		9: {2, 7},
+	}
+	if len(dups) != len(want) {
+		t.Fatalf("DuplicateDiscs = %v, want %v", dups, want)
+	}
+	for id, others := range want {
+		if !slices.Equal(dups[id], others) {
+			t.Errorf("dups[%d] = %v, want %v", id, dups[id], others)
+		}
+	}
+}
+
+func TestDuplicateDiscs_NoneShared(t *testing.T) {
+	queue := []spindle.QueueItem{{ID: 1, DiscFingerprint: "a"}, {ID: 2, DiscFingerprint: "b"}, {ID: 3}, {ID: 4}}
+	if dups := DuplicateDiscs(queue); len(dups) != 0 {
+		t.Fatalf("DuplicateDiscs = %v, want none", dups)
+	}
+}
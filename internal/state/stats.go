@@ -0,0 +1,46 @@
+package state
+
+import (
+	"strings"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// EncodeStats rolls up the encode results of completed items.
+type EncodeStats struct {
+	Count         int     // completed items with an encode result
+	OriginalBytes int64   // total source size
+	EncodedBytes  int64   // total encoded size
+	AvgReduction  float64 // mean per-item size reduction percent
+	AvgSpeed      float64 // mean average encode speed, over items reporting one
+}
+
+// ComputeEncodeStats aggregates the encode results of completed items. An
+// item counts when it reports both original and encoded sizes; items
+// without a result are skipped. Averages are zero when nothing counts.
+func ComputeEncodeStats(queue []spindle.QueueItem) EncodeStats {
+	var stats EncodeStats
+	var reductionSum, speedSum float64
+	speedCount := 0
+	for _, item := range queue {
+		enc := item.Encoding
+		if !strings.EqualFold(item.Stage, "completed") || enc == nil || enc.OriginalSize <= 0 || enc.EncodedSize <= 0 {
+			continue
+		}
+		stats.Count++
+		stats.OriginalBytes += enc.OriginalSize
+		stats.EncodedBytes += enc.EncodedSize
+		reductionSum += enc.SizeReductionPercent
+		if enc.AverageSpeed > 0 {
+			speedSum += enc.AverageSpeed
+			speedCount++
+		}
+	}
+	if stats.Count > 0 {
+		stats.AvgReduction = reductionSum / float64(stats.Count)
+	}
+	if speedCount > 0 {
+		stats.AvgSpeed = speedSum / float64(speedCount)
+	}
+	return stats
+}
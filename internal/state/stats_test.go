@@ -0,0 +1,31 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+func TestComputeEncodeStats_SkipsItemsWithoutResult(t *testing.T) {
+	queue := []spindle.QueueItem{
+		{ID: 1, Stage: "completed", Encoding: &spindle.EncodingStatus{OriginalSize: 1000, EncodedSize: 400, SizeReductionPercent: 60, AverageSpeed: 2}},
+		{ID: 2, Stage: "completed", Encoding: &spindle.EncodingStatus{OriginalSize: 3000, EncodedSize: 2400, SizeReductionPercent: 20}},
+		{ID: 3, Stage: "completed"}, // no encoding
+		{ID: 4, Stage: "completed", Encoding: &spindle.EncodingStatus{AverageSpeed: 9}}, // no sizes
+		{ID: 5, Stage: "encoding", Encoding: &spindle.EncodingStatus{OriginalSize: 500, EncodedSize: 100}},
+		{ID: 6, Stage: "Completed", Encoding: &spindle.EncodingStatus{OriginalSize: 2000, EncodedSize: 1000, SizeReductionPercent: 50, AverageSpeed: 4}},
+	}
+
+	got := ComputeEncodeStats(queue)
+	want := EncodeStats{Count: 3, OriginalBytes: 6000, EncodedBytes: 3800, AvgReduction: 130.0 / 3, AvgSpeed: 3}
+	if got != want {
+		t.Fatalf("ComputeEncodeStats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestComputeEncodeStats_EmptyHasZeroAverages(t *testing.T) {
+	got := ComputeEncodeStats([]spindle.QueueItem{{ID: 1, Stage: "completed"}})
+	if got != (EncodeStats{}) {
+		t.Fatalf("ComputeEncodeStats() = %+v, want zero", got)
+	}
+}
@@ -2,6 +2,7 @@ package state
 
 import (
 	"fmt"
+	"maps"
 	"sync"
 	"time"
 
@@ -15,7 +16,16 @@ type Snapshot struct {
 	Queue               []spindle.QueueItem
 	LastUpdated         time.Time
 	LastError           error
-	ConsecutiveFailures int // Number of consecutive poll failures
+	ConsecutiveFailures int       // Number of consecutive poll failures
+	RetryAt             time.Time // next poll after a failure; zero once a poll succeeds
+	Fetching            bool      // a poll is in flight; cleared when it is recorded
+	Endpoint            string    // daemon API endpoint being polled
+	ConfigError         error     // last failed config reload; nil once one succeeds
+
+	// Version increases with every recorded poll, failed ones included,
+	// and every other change apart from Fetching, so equal versions mean
+	// identical snapshots apart from Fetching. Zero means never updated.
+	Version uint64
 }
 
 // IsOffline returns true when the API has been unreachable for multiple polls.
@@ -23,10 +33,60 @@ func (s Snapshot) IsOffline() bool {
 	return s.ConsecutiveFailures >= 2
 }
 
+// historyLimit caps the queue-stats history at about 10 minutes of
+// 2-second polls.
+const historyLimit = 300
+
+// HistoryPoint is one recorded sample of the daemon's per-status queue
+// counts (WorkflowStatus.QueueStats).
+type HistoryPoint struct {
+	At     time.Time
+	Counts map[string]int
+}
+
 // Store coordinates concurrent updates to the snapshot.
 type Store struct {
 	mu       sync.RWMutex
 	snapshot Snapshot
+	subs     map[int]chan Snapshot
+	nextSub  int
+
+	// history is a ring of the last historyLimit queue-stats samples;
+	// historyHead indexes the oldest once the ring is full.
+	history     []HistoryPoint
+	historyHead int
+}
+
+// Subscribe returns a channel that receives a fresh snapshot after every
+// update, and a func that stops delivery and closes the channel. Any number
+// of subscribers may be registered. Delivery never blocks the updater: a
+// subscriber that has not drained its previous snapshot gets it replaced by
+// the newer one.
+func (s *Store) Subscribe() (<-chan Snapshot, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.subs == nil {
+		s.subs = make(map[int]chan Snapshot)
+	}
+	id := s.nextSub
+	s.nextSub++
+	ch := make(chan Snapshot, 1)
+	s.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.subs, id)
+			select {
+			case <-ch: // drop an undelivered snapshot
+			default:
+			}
+			close(ch)
+		})
+	}
 }
 
 // Update replaces the stored snapshot. When err is non-nil the previous data is
@@ -38,27 +98,217 @@ func (s *Store) Update(status *spindle.StatusResponse, queue []spindle.QueueItem
 	if err != nil {
 		s.snapshot.LastError = err
 		s.snapshot.LastUpdated = time.Now()
+		s.snapshot.Fetching = false
 		s.snapshot.ConsecutiveFailures++
+		s.snapshot.Version++
+		s.notifyLocked()
 		return
 	}
 
-	s.snapshot.Queue = cloneQueue(queue)
-	if status != nil {
-		s.snapshot.Status = *status
-		s.snapshot.HasStatus = true
-	} else {
-		s.snapshot.HasStatus = false
+	s.applyLocked(status, true, queue, true, nil)
+}
+
+// UpdatePartial records a poll that reached the daemon but in which either
+// side may be unchanged (a conditional fetch answered 304) or failed.
+// Unchanged fields keep their stored value without a copy. A non-nil err
+// reports the failed side: it becomes LastError but, since the daemon
+// answered, does not count as a failed poll.
+func (s *Store) UpdatePartial(status *spindle.StatusResponse, statusChanged bool, queue []spindle.QueueItem, queueChanged bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.applyLocked(status, statusChanged, queue, queueChanged, err)
+}
+
+// Merge records a successful poll whose queue side is a delta: items
+// replace stored items with the same ID and new IDs are appended. Stored
+// items absent from the delta are kept. err reports a failed status fetch
+// as in UpdatePartial.
+func (s *Store) Merge(status *spindle.StatusResponse, statusChanged bool, items []spindle.QueueItem, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue := s.snapshot.Queue
+	index := make(map[int64]int, len(queue))
+	for i, item := range queue {
+		index[item.ID] = i
+	}
+	for _, item := range items {
+		if i, ok := index[item.ID]; ok {
+			queue[i] = item.Clone()
+			continue
+		}
+		index[item.ID] = len(queue)
+		queue = append(queue, item.Clone())
+	}
+	s.snapshot.Queue = queue
+	s.applyLocked(status, statusChanged, nil, false, err)
+}
+
+// applyLocked applies a poll that reached the daemon; err is the failure of
+// one side, if any. Callers hold s.mu.
+func (s *Store) applyLocked(status *spindle.StatusResponse, statusChanged bool, queue []spindle.QueueItem, queueChanged bool, err error) {
+	if queueChanged {
+		s.snapshot.Queue = cloneQueue(queue)
+	}
+	if statusChanged {
+		if status != nil {
+			s.snapshot.Status = *status
+			s.snapshot.HasStatus = true
+		} else {
+			s.snapshot.HasStatus = false
+		}
 	}
-	s.snapshot.LastError = nil
+	s.snapshot.LastError = err
 	s.snapshot.LastUpdated = time.Now()
+	s.snapshot.Fetching = false
 	s.snapshot.ConsecutiveFailures = 0
+	s.snapshot.RetryAt = time.Time{}
+	s.snapshot.Version++
+	s.recordHistoryLocked()
+	s.notifyLocked()
+}
+
+// recordHistoryLocked samples the current queue stats into the history
+// ring. Polls without a status record nothing. Callers hold s.mu.
+func (s *Store) recordHistoryLocked() {
+	if !s.snapshot.HasStatus {
+		return
+	}
+	point := HistoryPoint{
+		At:     s.snapshot.LastUpdated,
+		Counts: maps.Clone(s.snapshot.Status.Workflow.QueueStats),
+	}
+	if len(s.history) < historyLimit {
+		s.history = append(s.history, point)
+		return
+	}
+	s.history[s.historyHead] = point
+	s.historyHead = (s.historyHead + 1) % historyLimit
+}
+
+// History returns up to maxPoints of the most recent queue-stats samples,
+// oldest first; maxPoints <= 0 returns all of them. Only successful polls
+// with a status are recorded, and at most the last 300 are kept.
+func (s *Store) History(maxPoints int) []HistoryPoint {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	total := len(s.history)
+	n := total
+	if maxPoints > 0 && maxPoints < n {
+		n = maxPoints
+	}
+	if n == 0 {
+		return nil
+	}
+	points := make([]HistoryPoint, n)
+	for i := range points {
+		p := s.history[(s.historyHead+total-n+i)%total]
+		p.Counts = maps.Clone(p.Counts)
+		points[i] = p
+	}
+	return points
+}
+
+// notifyLocked delivers the current snapshot to every subscriber without
+// blocking, replacing any snapshot a slow subscriber has not yet received.
+// Callers hold s.mu.
+func (s *Store) notifyLocked() {
+	if len(s.subs) == 0 {
+		return
+	}
+	snap := s.copyLocked()
+	for _, ch := range s.subs {
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- snap:
+		default:
+		}
+	}
+}
+
+// BeginFetch marks a poll as in flight. Recording the poll's result with
+// Update, UpdatePartial or Merge clears the mark. Version is left alone,
+// since the poll data has not changed, so subscribers that skip work on an
+// unchanged version still see Fetching.
+func (s *Store) BeginFetch() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshot.Fetching = true
+	s.notifyLocked()
+}
+
+// ScheduleRetry records when the poller will retry after a failed poll.
+// The next successful poll clears it.
+func (s *Store) ScheduleRetry(at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshot.RetryAt = at
+	s.snapshot.Version++
+	s.notifyLocked()
+}
+
+// SetEndpoint records the daemon endpoint being polled. Switching from one
+// endpoint to another drops the old daemon's status, queue, errors and
+// history, so nothing from it lingers while the new one is first polled.
+func (s *Store) SetEndpoint(endpoint string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if endpoint == s.snapshot.Endpoint {
+		return
+	}
+	if s.snapshot.Endpoint != "" {
+		s.snapshot = Snapshot{
+			Fetching:    s.snapshot.Fetching,
+			ConfigError: s.snapshot.ConfigError,
+			Version:     s.snapshot.Version,
+		}
+		s.history, s.historyHead = nil, 0
+	}
+	s.snapshot.Endpoint = endpoint
+	s.snapshot.Version++
+	s.notifyLocked()
+}
+
+// Endpoint returns the endpoint recorded by SetEndpoint.
+func (s *Store) Endpoint() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.snapshot.Endpoint
+}
+
+// SetConfigError records why a config reload failed; nil clears it.
+func (s *Store) SetConfigError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil && s.snapshot.ConfigError == nil {
+		return
+	}
+	s.snapshot.ConfigError = err
+	s.snapshot.Version++
+	s.notifyLocked()
+}
+
+// Version returns the current snapshot version without copying the queue.
+func (s *Store) Version() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.snapshot.Version
 }
 
 // Snapshot returns a copy of the current snapshot.
 func (s *Store) Snapshot() Snapshot {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
+	return s.copyLocked()
+}
 
+// copyLocked returns a copy of the snapshot that shares no mutable state
+// with the store. Callers hold s.mu.
+func (s *Store) copyLocked() Snapshot {
 	snap := s.snapshot
 	snap.Queue = cloneQueue(s.snapshot.Queue)
 	if s.snapshot.LastError != nil {
@@ -67,11 +317,15 @@ func (s *Store) Snapshot() Snapshot {
 	return snap
 }
 
+// cloneQueue deep-copies items (see QueueItem.Clone), so a consumer
+// mutating a snapshot's nested slices or metadata cannot race the poller.
 func cloneQueue(items []spindle.QueueItem) []spindle.QueueItem {
 	if len(items) == 0 {
 		return nil
 	}
 	dup := make([]spindle.QueueItem, len(items))
-	copy(dup, items)
+	for i, item := range items {
+		dup[i] = item.Clone()
+	}
 	return dup
 }
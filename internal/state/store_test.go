@@ -2,6 +2,7 @@ package state
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -40,6 +41,36 @@ func TestStore_UpdateAndSnapshotClone(t *testing.T) {
 	}
 }
 
+func TestStore_SnapshotDeepCopiesNestedFields(t *testing.T) {
+	var s Store
+	s.Update(nil, []spindle.QueueItem{{
+		ID:            1,
+		ReviewReasons: []string{"low match score"},
+		Metadata:      []byte(`{"title":"Heat"}`),
+		Tasks:         []spindle.Task{{Type: "encoding", DependsOn: []string{"ripping"}}},
+		Episodes:      []spindle.EpisodeStatus{{Key: "s01e01", SubtitleReviewIssues: []string{"drift"}}},
+		Encoding:      &spindle.EncodingStatus{Percent: 40, Error: &spindle.EncodingIssue{Title: "boom"}},
+	}}, nil)
+
+	snap := s.Snapshot()
+	item := &snap.Queue[0]
+	item.ReviewReasons[0] = "mutated"
+	item.Metadata[2] = 'X'
+	item.Tasks[0].DependsOn[0] = "mutated"
+	item.Episodes[0].Key = "mutated"
+	item.Episodes[0].SubtitleReviewIssues[0] = "mutated"
+	item.Encoding.Percent = 99
+	item.Encoding.Error.Title = "mutated"
+
+	got := s.Snapshot().Queue[0]
+	if got.ReviewReasons[0] != "low match score" || string(got.Metadata) != `{"title":"Heat"}` ||
+		got.Tasks[0].DependsOn[0] != "ripping" || got.Episodes[0].Key != "s01e01" ||
+		got.Episodes[0].SubtitleReviewIssues[0] != "drift" || got.Encoding.Percent != 40 ||
+		got.Encoding.Error.Title != "boom" {
+		t.Fatalf("mutating a snapshot leaked into the store: %+v", got)
+	}
+}
+
 func TestStore_UpdateErrorKeepsPreviousData(t *testing.T) {
 	var s Store
 
@@ -165,3 +196,247 @@ func TestStore_ConcurrentAccess(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestStore_UpdatePartialKeepsUnchangedFields(t *testing.T) {
+	var s Store
+	s.Update(&spindle.StatusResponse{PID: 1}, []spindle.QueueItem{{ID: 1}}, nil)
+	s.Update(nil, nil, errors.New("blip"))
+
+	s.UpdatePartial(&spindle.StatusResponse{PID: 2}, true, nil, false, nil)
+	snap := s.Snapshot()
+	if !snap.HasStatus || snap.Status.PID != 2 {
+		t.Fatalf("status = %#v, want updated pid=2", snap.Status)
+	}
+	if len(snap.Queue) != 1 || snap.Queue[0].ID != 1 {
+		t.Fatalf("queue = %#v, want unchanged item 1", snap.Queue)
+	}
+	if snap.LastError != nil || snap.ConsecutiveFailures != 0 {
+		t.Fatalf("partial update should clear failure state, got err=%v failures=%d", snap.LastError, snap.ConsecutiveFailures)
+	}
+
+	s.UpdatePartial(nil, false, []spindle.QueueItem{{ID: 3}}, true, nil)
+	snap = s.Snapshot()
+	if snap.Status.PID != 2 || len(snap.Queue) != 1 || snap.Queue[0].ID != 3 {
+		t.Fatalf("got status=%#v queue=%#v, want pid=2 and item 3", snap.Status, snap.Queue)
+	}
+}
+
+func TestStore_UpdatePartialRecordsFailedSide(t *testing.T) {
+	var s Store
+	s.Update(&spindle.StatusResponse{PID: 1}, []spindle.QueueItem{{ID: 1}}, nil)
+	s.Update(nil, nil, errors.New("down"))
+
+	s.UpdatePartial(&spindle.StatusResponse{PID: 2}, true, nil, false, errors.New("queue: boom"))
+	snap := s.Snapshot()
+	if snap.Status.PID != 2 || len(snap.Queue) != 1 || snap.Queue[0].ID != 1 {
+		t.Fatalf("got status=%#v queue=%#v, want pid=2 and stale item 1", snap.Status, snap.Queue)
+	}
+	if snap.LastError == nil || snap.LastError.Error() != "queue: boom" {
+		t.Fatalf("LastError = %v, want the failed side recorded", snap.LastError)
+	}
+	if snap.ConsecutiveFailures != 0 || snap.IsOffline() {
+		t.Fatalf("ConsecutiveFailures = %d, want 0 when the daemon answered", snap.ConsecutiveFailures)
+	}
+}
+
+func TestStore_MergeDelta(t *testing.T) {
+	var s Store
+	s.Update(&spindle.StatusResponse{PID: 1}, []spindle.QueueItem{{ID: 1, Stage: "ripping"}, {ID: 2, Stage: "pending"}}, nil)
+	before := s.Snapshot()
+	s.Update(nil, nil, errors.New("blip"))
+
+	s.Merge(&spindle.StatusResponse{PID: 2}, true, []spindle.QueueItem{{ID: 2, Stage: "encoding"}, {ID: 3, Stage: "pending"}}, nil)
+	snap := s.Snapshot()
+	if snap.Status.PID != 2 || snap.LastError != nil || snap.ConsecutiveFailures != 0 {
+		t.Fatalf("merge should apply status and clear failures, got pid=%d err=%v failures=%d", snap.Status.PID, snap.LastError, snap.ConsecutiveFailures)
+	}
+	want := []string{"ripping", "encoding", "pending"}
+	if len(snap.Queue) != len(want) {
+		t.Fatalf("queue = %#v, want 3 items", snap.Queue)
+	}
+	for i, stage := range want {
+		if snap.Queue[i].ID != int64(i+1) || snap.Queue[i].Stage != stage {
+			t.Fatalf("queue[%d] = %#v, want #%d %s", i, snap.Queue[i], i+1, stage)
+		}
+	}
+	if before.Queue[1].Stage != "pending" {
+		t.Fatalf("merge must not modify earlier snapshots")
+	}
+
+	s.Merge(nil, false, nil, nil)
+	if snap := s.Snapshot(); snap.Status.PID != 2 || len(snap.Queue) != 3 {
+		t.Fatalf("empty delta should keep everything, got pid=%d queue=%d", snap.Status.PID, len(snap.Queue))
+	}
+}
+
+func TestStore_SubscribeDeliversUpdates(t *testing.T) {
+	var store Store
+	first, unsubFirst := store.Subscribe()
+	second, unsubSecond := store.Subscribe()
+	defer unsubSecond()
+
+	store.Update(&spindle.StatusResponse{Running: true}, []spindle.QueueItem{{ID: 1}}, nil)
+	for name, ch := range map[string]<-chan Snapshot{"first": first, "second": second} {
+		select {
+		case snap := <-ch:
+			if !snap.HasStatus || len(snap.Queue) != 1 {
+				t.Fatalf("%s subscriber got %#v, want the update", name, snap)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%s subscriber received nothing", name)
+		}
+	}
+
+	// A slow subscriber keeps only the newest snapshot; updates never block.
+	store.Update(nil, nil, errors.New("one"))
+	store.Update(nil, nil, errors.New("two"))
+	if snap := <-second; snap.ConsecutiveFailures != 2 {
+		t.Fatalf("slow subscriber got failures=%d, want the newest snapshot (2)", snap.ConsecutiveFailures)
+	}
+
+	unsubFirst()
+	unsubFirst() // idempotent
+	store.Update(&spindle.StatusResponse{}, nil, nil)
+	if _, ok := <-first; ok {
+		t.Fatalf("unsubscribed channel still delivered a snapshot")
+	}
+	if snap := <-second; snap.ConsecutiveFailures != 0 {
+		t.Fatalf("remaining subscriber got failures=%d, want 0", snap.ConsecutiveFailures)
+	}
+}
+
+func TestStore_VersionIncrementsOnEveryUpdate(t *testing.T) {
+	var store Store
+	if v := store.Version(); v != 0 {
+		t.Fatalf("initial Version() = %d, want 0", v)
+	}
+
+	store.Update(&spindle.StatusResponse{}, nil, nil)
+	store.Update(nil, nil, errors.New("offline"))
+	store.UpdatePartial(nil, false, nil, false, nil)
+	store.Merge(nil, false, []spindle.QueueItem{{ID: 1}}, nil)
+	if v := store.Version(); v != 4 {
+		t.Fatalf("Version() after 4 updates = %d, want 4", v)
+	}
+
+	first, second := store.Snapshot(), store.Snapshot()
+	if first.Version != 4 || second.Version != 4 {
+		t.Fatalf("Snapshot versions = %d, %d, want stable 4", first.Version, second.Version)
+	}
+}
+
+func TestStore_HistoryWrapsInChronologicalOrder(t *testing.T) {
+	var store Store
+	if got := store.History(10); got != nil {
+		t.Fatalf("History() on empty store = %v, want nil", got)
+	}
+
+	total := historyLimit + 5
+	for i := range total {
+		status := &spindle.StatusResponse{Workflow: spindle.WorkflowStatus{
+			QueueStats: map[string]int{"encoding": i},
+		}}
+		store.Update(status, nil, nil)
+		if i == 0 {
+			// Failed polls and polls without a status are not sampled.
+			store.Update(nil, nil, errors.New("offline"))
+		}
+	}
+
+	all := store.History(0)
+	if len(all) != historyLimit {
+		t.Fatalf("History(0) len = %d, want capped at %d", len(all), historyLimit)
+	}
+	if first, last := all[0].Counts["encoding"], all[len(all)-1].Counts["encoding"]; first != 5 || last != total-1 {
+		t.Fatalf("History(0) spans %d..%d, want 5..%d", first, last, total-1)
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i].Counts["encoding"] != all[i-1].Counts["encoding"]+1 || all[i].At.Before(all[i-1].At) {
+			t.Fatalf("History(0) out of order at %d", i)
+		}
+	}
+
+	recent := store.History(3)
+	if len(recent) != 3 || recent[0].Counts["encoding"] != total-3 || recent[2].Counts["encoding"] != total-1 {
+		t.Fatalf("History(3) = %v, want the last three samples oldest first", recent)
+	}
+	recent[0].Counts["encoding"] = -1
+	if store.History(3)[0].Counts["encoding"] != total-3 {
+		t.Fatalf("History() returned counts shared with the store")
+	}
+}
+
+func TestStore_FetchingClearedWhenPollRecorded(t *testing.T) {
+	var store Store
+	record := map[string]func(){
+		"Update":        func() { store.Update(&spindle.StatusResponse{}, nil, nil) },
+		"Update failed": func() { store.Update(nil, nil, errors.New("offline")) },
+		"UpdatePartial": func() { store.UpdatePartial(nil, false, nil, false, nil) },
+		"Merge":         func() { store.Merge(nil, false, nil, nil) },
+	}
+	for name, fn := range record {
+		before := store.Version()
+		store.BeginFetch()
+		if snap := store.Snapshot(); !snap.Fetching || snap.Version != before {
+			t.Fatalf("after BeginFetch: Fetching=%v Version=%d, want true and unchanged %d", snap.Fetching, snap.Version, before)
+		}
+		fn()
+		if store.Snapshot().Fetching {
+			t.Fatalf("Fetching still set after %s", name)
+		}
+	}
+}
+
+func TestStore_SetEndpointDropsOldDaemonData(t *testing.T) {
+	var store Store
+	store.SetEndpoint("http://a:7487")
+	store.Update(&spindle.StatusResponse{Running: true}, []spindle.QueueItem{{ID: 1}}, nil)
+	store.SetConfigError(errors.New("bad toml"))
+
+	before := store.Version()
+	store.SetEndpoint("http://a:7487")
+	if store.Version() != before {
+		t.Fatalf("setting the current endpoint bumped the version")
+	}
+
+	store.SetEndpoint("http://b:7487")
+	snap := store.Snapshot()
+	if snap.Endpoint != "http://b:7487" || snap.HasStatus || len(snap.Queue) != 0 || snap.Version <= before {
+		t.Fatalf("after switching endpoint snapshot = %+v, want the old daemon's data dropped", snap)
+	}
+	if snap.ConfigError == nil {
+		t.Fatalf("switching endpoint cleared the config error")
+	}
+	if got := store.History(0); got != nil {
+		t.Fatalf("switching endpoint kept the old daemon's history: %v", got)
+	}
+
+	store.SetConfigError(nil)
+	if store.Snapshot().ConfigError != nil {
+		t.Fatalf("SetConfigError(nil) kept the error")
+	}
+}
+
+func BenchmarkStore_Snapshot(b *testing.B) {
+	queue := make([]spindle.QueueItem, 100)
+	for i := range queue {
+		episodes := make([]spindle.EpisodeStatus, 12)
+		for j := range episodes {
+			episodes[j] = spindle.EpisodeStatus{Key: fmt.Sprintf("s01e%02d", j+1), SubtitleReviewIssues: []string{"drift"}}
+		}
+		queue[i] = spindle.QueueItem{
+			ID:       int64(i + 1),
+			Metadata: []byte(`{"title":"Show","media_type":"tv"}`),
+			Tasks:    []spindle.Task{{Type: "ripping"}, {Type: "encoding", DependsOn: []string{"ripping"}}},
+			Episodes: episodes,
+			Encoding: &spindle.EncodingStatus{Percent: 50},
+		}
+	}
+	var s Store
+	s.Update(&spindle.StatusResponse{}, queue, nil)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = s.Snapshot()
+	}
+}
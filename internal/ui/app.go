@@ -3,6 +3,9 @@ package ui
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,8 +14,10 @@ import (
 	"charm.land/bubbles/v2/viewport"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
 
 	"github.com/five82/flyer/internal/config"
+	"github.com/five82/flyer/internal/opener"
 	"github.com/five82/flyer/internal/prefs"
 	"github.com/five82/flyer/internal/spindle"
 	"github.com/five82/flyer/internal/state"
@@ -25,6 +30,11 @@ const (
 	ViewQueue View = iota
 	ViewLogs
 	ViewProblems
+	ViewCompleted
+	ViewStats
+	ViewHealth
+	ViewWatch
+	ViewReasons
 )
 
 // inspectorTab identifies a tab inside the item inspector.
@@ -46,6 +56,18 @@ const (
 	FilterFailed
 	FilterReview
 	FilterProcessing
+	FilterPinned
+)
+
+// QueueSort selects the queue table's ordering.
+type QueueSort int
+
+const (
+	SortPriority QueueSort = iota // review, failed, live work, then ID
+	SortID
+	SortTitle
+	SortUpdated
+	SortProgress
 )
 
 // detailState holds per-item detail view state.
@@ -56,53 +78,92 @@ type detailState struct {
 // Options configures the UI.
 type Options struct {
 	Context   context.Context
-	Client    *spindle.Client
+	Client    spindle.StatusFetcher
 	Store     *state.Store
 	Config    *config.Config
 	PollTick  time.Duration
 	ThemeName string
 	PrefsPath string
+	Prefs     prefs.Prefs
+
+	// ThemeDir holds custom theme files (*.toml) added to the theme cycle.
+	// Empty loads none.
+	ThemeDir string
 
 	// Refresh forces an immediate poll of the Spindle API, updating the
 	// store. Used by the manual refresh key.
 	Refresh func() error
+
+	// CheckUpdate looks up a newer Flyer release once at startup, off the
+	// UI loop, returning its version or "". Nil skips the check.
+	CheckUpdate func() string
+
+	// APIEndpoint is the daemon endpoint in use; Opener opens an item's
+	// output when that endpoint is local. A nil Opener disables opening.
+	APIEndpoint string
+	Opener      opener.Opener
 }
 
 // Model is the root application state for Bubble Tea.
 type Model struct {
 	// Configuration
-	ctx       context.Context
-	client    *spindle.Client
-	store     *state.Store
-	config    *config.Config
-	prefsPath string
-	pollTick  time.Duration
-	refreshFn func() error
+	ctx         context.Context
+	client      spindle.StatusFetcher
+	store       *state.Store
+	config      *config.Config
+	prefsPath   string
+	prefs       prefs.Prefs
+	pollTick    time.Duration
+	refreshFn   func() error
+	checkUpdate func() string
+	apiEndpoint string
+	opener      opener.Opener
+
+	// storeUpdates delivers store snapshots as the poller records them;
+	// when set, the tick no longer re-reads the store.
+	storeUpdates <-chan state.Snapshot
+
+	// now is the model clock; tests inject a fake one.
+	now func() time.Time
 
 	// Key bindings
 	keys keyMap
 
 	// UI state
-	theme       Theme
-	currentView View
-	width       int
-	height      int
-	ready       bool
+	theme        Theme
+	customThemes []Theme // file-defined themes, cycled after the built-ins
+	currentView  View
+	width        int
+	height       int
+	ready        bool
 
 	// Data state
 	snapshot    state.Snapshot
 	lastUpdated time.Time
+	etas        etaSmoother       // smoothed encode ETAs by item ID
+	duplicates  map[int64][]int64 // items sharing a disc fingerprint, by item ID
+	newVersion  string            // newer Flyer release found at startup
 
 	// Queue state
-	selectedRow int
-	queueScroll int
-	filterMode  QueueFilter
+	selectedRow    int // index into queueRows()
+	queueScroll    int
+	filterMode     QueueFilter
+	queueSort      QueueSort
+	queueSortDesc  bool            // queueSort runs high to low
+	queueGrouped   bool            // items grouped under lane headers
+	queueCollapsed map[string]bool // collapsed lanes by name
+	sortedCache    *sortedItemsCache
+	queueLastNav   time.Time // last queue navigation keypress, for follow
 
 	// Queue text filter ("/" in the queue view)
 	queueFilterActive bool // input is capturing keys
 	queueFilterQuery  string
 	queueFilterInput  textinput.Model
 
+	// Queue jump-to-item input (":" in the queue view)
+	queueJumpActive bool
+	queueJumpInput  textinput.Model
+
 	// Spinner shown while connecting/offline
 	spinnerFrame int
 	spinnerOn    bool
@@ -113,23 +174,40 @@ type Model struct {
 	inspectedID       int64
 	returnView        View // view Esc returns to
 	inspectorViewport viewport.Model
+	inspectorBuilt    detailKey // inputs of the viewport's current content
 	detailState       detailState
 
+	// watchID is the item the watch view follows.
+	watchID int64
+
 	// Log state
-	logViewport viewport.Model
-	logState    logState
+	logViewport   viewport.Model
+	logState      logState
+	logHighlights []logHighlight // always-on highlights from prefs
 
 	// Problems (triage) state
 	problemsRow    int
 	problemsScroll int
 	problemsState  problemsState
 
+	// Health view state
+	healthState healthState
+
+	// Completed view state
+	completedRow    int
+	completedScroll int
+
+	// Review reasons view state; reviewReason narrows FilterReview to one
+	// bucket ("" = every review item).
+	reasonsRow   int
+	reviewReason string
+
 	// Modal overlay (help, log filters, etc.)
 	activeModal Modal
 
 	// Log filters modal state (separate from Modal interface for simplicity)
 	showLogFilters    bool
-	logFilterInputs   [4]textinput.Model // level, component, lane, request
+	logFilterInputs   [6]textinput.Model // level, component, lane, request, from, to
 	logFilterFocusIdx int
 
 	// Transient error display
@@ -153,6 +231,10 @@ func New(opts Options) Model {
 	if themeName == "" {
 		themeName = "Slate"
 	}
+	// https://no-color.org: any non-empty NO_COLOR opts out of color.
+	if os.Getenv("NO_COLOR") != "" {
+		themeName = monoThemeName
+	}
 
 	prefsPath := opts.PrefsPath
 	if prefsPath == "" {
@@ -164,23 +246,51 @@ func New(opts Options) Model {
 	filterInput.Placeholder = "title or #id"
 	filterInput.CharLimit = 80
 
-	return Model{
+	jumpInput := textinput.New()
+	jumpInput.Prompt = "" // the filter line renders its own ":" prefix
+	jumpInput.Placeholder = "item id"
+	jumpInput.CharLimit = 20
+
+	highlights, warnings := compileLogHighlights(opts.Prefs.LogHighlights)
+	customThemes, themeWarnings := LoadThemeDir(opts.ThemeDir)
+	warnings = append(warnings, themeWarnings...)
+	if opts.Config != nil {
+		warnings = append(warnings, opts.Config.Warnings...)
+	}
+
+	m := Model{
 		ctx:              ctx,
 		client:           opts.Client,
 		store:            opts.Store,
 		config:           opts.Config,
 		prefsPath:        prefsPath,
+		prefs:            opts.Prefs,
 		pollTick:         pollTick,
 		refreshFn:        opts.Refresh,
+		checkUpdate:      opts.CheckUpdate,
+		apiEndpoint:      opts.APIEndpoint,
+		opener:           opts.Opener,
+		now:              time.Now,
 		keys:             DefaultKeyMap(),
-		theme:            GetTheme(themeName),
+		theme:            GetTheme(themeName, customThemes...),
+		customThemes:     customThemes,
 		currentView:      ViewQueue,
 		queueFilterInput: filterInput,
+		queueJumpInput:   jumpInput,
 		spinnerOn:        true,
+		logHighlights:    highlights,
+		sortedCache:      &sortedItemsCache{},
+		filterMode:       queueFilterFromPref(opts.Prefs.QueueFilter),
+		queueGrouped:     opts.Prefs.QueueLayout == prefs.QueueLayoutLanes,
 		detailState: detailState{
 			episodeCollapsed: make(map[int64]bool),
 		},
 	}
+	if len(warnings) > 0 {
+		m.errorMsg = strings.Join(warnings, "; ")
+		m.errorExpiry = time.Now().Add(10 * time.Second)
+	}
+	return m
 }
 
 // Init implements tea.Model.
@@ -189,10 +299,19 @@ func (m Model) Init() tea.Cmd {
 		tickCmd(m.pollTick),
 		spinnerTickCmd(),
 	}
+	if d := m.detailRefreshInterval(); d > 0 {
+		cmds = append(cmds, detailTickCmd(d))
+	}
 	// Fetch snapshot immediately on start
 	if m.store != nil {
 		cmds = append(cmds, fetchSnapshotCmd(m.store))
 	}
+	if m.storeUpdates != nil {
+		cmds = append(cmds, waitStoreUpdateCmd(m.storeUpdates))
+	}
+	if check := m.checkUpdate; check != nil {
+		cmds = append(cmds, func() tea.Msg { return updateAvailableMsg(check()) })
+	}
 	return tea.Batch(cmds...)
 }
 
@@ -217,6 +336,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateLogViewport()
 		return m, nil
 
+	case tea.ColorProfileMsg:
+		// A terminal without color support gets the monochrome theme for
+		// this session; the saved theme preference is left alone.
+		if (msg.Profile == colorprofile.ASCII || msg.Profile == colorprofile.NoTTY) && !m.theme.Monochrome {
+			m.theme = GetTheme(monoThemeName)
+			m.updateInspectorViewport()
+			m.updateLogViewport()
+		}
+		return m, nil
+
 	case tickMsg:
 		return m.handleTick()
 
@@ -228,17 +357,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.spinnerOn = false
 		return m, nil
 
-	case snapshotMsg:
-		m.snapshot = state.Snapshot(msg)
-		m.lastUpdated = time.Now()
-		m.updateQueueTable()
-		m.clampProblemsRow()
-		m.updateInspectorViewport()
-		// Restart the spinner if the daemon went offline while it was idle.
-		if m.spinnerActive() && !m.spinnerOn {
-			m.spinnerOn = true
-			return m, spinnerTickCmd()
+	case detailTickMsg:
+		if m.inspecting && m.inspectorTab == tabOverview {
+			m.updateInspectorViewport()
 		}
+		return m, detailTickCmd(m.detailRefreshInterval())
+
+	case snapshotMsg:
+		return m, m.applySnapshot(state.Snapshot(msg))
+
+	case storeUpdateMsg:
+		cmd := m.applySnapshot(state.Snapshot(msg))
+		return m, tea.Batch(cmd, waitStoreUpdateCmd(m.storeUpdates))
+
+	case updateAvailableMsg:
+		m.newVersion = string(msg)
 		return m, nil
 
 	case logBatchMsg:
@@ -258,6 +391,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.errorMsg = "Problems fetch failed"
 		m.errorExpiry = time.Now().Add(5 * time.Second)
 		return m, nil
+
+	case dependenciesMsg:
+		m.handleDependencies(msg)
+		return m, nil
 	}
 
 	return m, nil
@@ -275,6 +412,14 @@ func (m Model) View() tea.View {
 		return v
 	}
 
+	if terminalTooSmall(m.width, m.height) {
+		v = tea.NewView(lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
+			styles.WarningText.Render(fmt.Sprintf("Terminal too small: %dx%d (need %dx%d)",
+				m.width, m.height, minTerminalWidth, minTerminalHeight))))
+		v.AltScreen = true
+		return v
+	}
+
 	// Modal overlays render centered over the dimmed main view (scrim).
 	if m.activeModal != nil {
 		v = tea.NewView(overlayCenter(m.renderMain(), m.activeModal.View(m.theme, m.width, m.height), m.width, m.height, styles))
@@ -293,6 +438,18 @@ func (m Model) View() tea.View {
 	return v
 }
 
+// Below this terminal size the panels lose too much to be readable, so the
+// view shows a notice instead.
+const (
+	minTerminalWidth  = 60
+	minTerminalHeight = 15
+)
+
+// terminalTooSmall reports whether the terminal is below the minimum size.
+func terminalTooSmall(width, height int) bool {
+	return width < minTerminalWidth || height < minTerminalHeight
+}
+
 // handleKey processes keyboard input.
 func (m Model) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	// Handle active modal
@@ -316,10 +473,13 @@ func (m Model) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		return m.handleLogsKey(msg)
 	}
 
-	// Queue filter input captures keys the same way.
+	// Queue filter and jump inputs capture keys the same way.
 	if m.queueFilterCapturing() {
 		return m.handleQueueFilterKey(msg)
 	}
+	if m.queueJumpActive && m.currentView == ViewQueue && !m.inspecting {
+		return m.handleQueueJumpKey(msg)
+	}
 
 	// Global keys
 	switch {
@@ -334,10 +494,9 @@ func (m Model) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		return m, m.manualRefreshCmds()
 
 	case key.Matches(msg, m.keys.CycleTheme):
-		m.theme = GetTheme(NextTheme(m.theme.Name))
-		if m.prefsPath != "" {
-			_ = prefs.Save(m.prefsPath, prefs.Prefs{Theme: m.theme.Name})
-		}
+		m.theme = GetTheme(NextTheme(m.theme.Name, m.customThemes...), m.customThemes...)
+		m.prefs.Theme = m.theme.Name
+		m.savePrefs()
 		m.updateInspectorViewport()
 		m.updateLogViewport()
 		return m, nil
@@ -356,6 +515,28 @@ func (m Model) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		m.currentView = ViewProblems
 		m.clampProblemsRow()
 		return m, nil
+
+	case key.Matches(msg, m.keys.ViewCompleted):
+		m.inspecting = false
+		m.currentView = ViewCompleted
+		m.clampCompletedRow()
+		return m, nil
+
+	case key.Matches(msg, m.keys.ViewStats):
+		m.inspecting = false
+		m.currentView = ViewStats
+		return m, nil
+
+	case key.Matches(msg, m.keys.ViewHealth):
+		m.inspecting = false
+		m.currentView = ViewHealth
+		return m, m.refreshDependencies()
+
+	case key.Matches(msg, m.keys.ViewReasons):
+		m.inspecting = false
+		m.currentView = ViewReasons
+		m.clampReasonsRow()
+		return m, nil
 	}
 
 	// Inspector captures the rest of the keys while open
@@ -371,6 +552,16 @@ func (m Model) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		return m.handleLogsKey(msg)
 	case ViewProblems:
 		return m.handleProblemsKey(msg)
+	case ViewCompleted:
+		return m.handleCompletedKey(msg)
+	case ViewStats:
+		return m.handleStatsKey(msg)
+	case ViewHealth:
+		return m.handleHealthKey(msg)
+	case ViewWatch:
+		return m.handleWatchKey(msg)
+	case ViewReasons:
+		return m.handleReasonsKey(msg)
 	}
 
 	return m, nil
@@ -387,9 +578,10 @@ func (m Model) logSearchCapturing() bool {
 	return m.inspecting && m.inspectorTab == tabLogs
 }
 
-// openDaemonLogs switches to the daemon log view.
+// openDaemonLogs switches to the daemon log view, keeping the all-items
+// mode when it is on.
 func (m Model) openDaemonLogs() (tea.Model, tea.Cmd) {
-	if m.logState.mode != logSourceDaemon {
+	if m.logState.mode == logSourceItem {
 		m.logState.mode = logSourceDaemon
 		m.logState.rawLines = nil
 		m.logState.streamCursor = 0
@@ -397,12 +589,23 @@ func (m Model) openDaemonLogs() (tea.Model, tea.Cmd) {
 		m.logState.contentVersion++
 	}
 	m.currentView = ViewLogs
+	m.logState.lastInput = m.clock()
 	m.updateLogViewport()
 	return m, m.refreshLogs(nil)
 }
 
-// cycleFilter cycles through queue filter modes.
+// savePrefs writes the current preferences, ignoring failures: losing a
+// remembered setting is not worth interrupting the session.
+func (m *Model) savePrefs() {
+	if m.prefsPath != "" {
+		_ = prefs.Save(m.prefsPath, m.prefs)
+	}
+}
+
+// cycleFilter cycles through queue filter modes, dropping any review
+// reason narrowing.
 func (m *Model) cycleFilter() {
+	m.reviewReason = ""
 	switch m.filterMode {
 	case FilterAll:
 		m.filterMode = FilterFailed
@@ -410,6 +613,8 @@ func (m *Model) cycleFilter() {
 		m.filterMode = FilterReview
 	case FilterReview:
 		m.filterMode = FilterProcessing
+	case FilterProcessing:
+		m.filterMode = FilterPinned
 	default:
 		m.filterMode = FilterAll
 	}
@@ -417,13 +622,40 @@ func (m *Model) cycleFilter() {
 
 // filterLabel returns the display label for the current filter mode.
 func (m *Model) filterLabel() string {
-	switch m.filterMode {
+	return queueFilterLabel(m.filterMode)
+}
+
+// filterDescription returns the filter label with the review reason it is
+// narrowed to, e.g. "Review: low match score".
+func (m *Model) filterDescription() string {
+	if m.filterMode == FilterReview && m.reviewReason != "" {
+		return m.filterLabel() + ": " + m.reviewReason
+	}
+	return m.filterLabel()
+}
+
+// queueFilterFromPref maps a queue_filter pref (a lowercase filter label)
+// to its filter, FilterAll when unknown.
+func queueFilterFromPref(name string) QueueFilter {
+	for _, f := range []QueueFilter{FilterFailed, FilterReview, FilterProcessing, FilterPinned} {
+		if strings.EqualFold(name, queueFilterLabel(f)) {
+			return f
+		}
+	}
+	return FilterAll
+}
+
+// queueFilterLabel returns the display label for a filter mode.
+func queueFilterLabel(filter QueueFilter) string {
+	switch filter {
 	case FilterFailed:
 		return "Failed"
 	case FilterReview:
 		return "Review"
 	case FilterProcessing:
 		return "Active"
+	case FilterPinned:
+		return "Pinned"
 	default:
 		return "All"
 	}
@@ -435,6 +667,48 @@ func (m Model) handleQueueKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, m.keys.CycleFilter):
 		m.cycleFilter()
 		m.updateQueueTable()
+		m.prefs.QueueFilter = strings.ToLower(m.filterLabel())
+		m.savePrefs()
+		return m, nil
+
+	case key.Matches(msg, m.keys.CycleSort):
+		m.cycleSort()
+		m.updateQueueTable()
+		return m, nil
+
+	case key.Matches(msg, m.keys.ReverseSort):
+		m.queueSortDesc = !m.queueSortDesc
+		m.updateQueueTable()
+		return m, nil
+
+	case key.Matches(msg, m.keys.ExportQueue):
+		m.errorMsg = m.exportQueue(".")
+		m.errorExpiry = time.Now().Add(5 * time.Second)
+		return m, nil
+
+	case key.Matches(msg, m.keys.OpenOutput):
+		m.errorMsg = m.openOutput(m.getSelectedItem())
+		m.errorExpiry = time.Now().Add(5 * time.Second)
+		return m, nil
+
+	case key.Matches(msg, m.keys.WatchItem):
+		return m.watchSelected()
+
+	case key.Matches(msg, m.keys.ToggleTimes):
+		m.prefs.QueueAbsoluteTimes = !m.prefs.QueueAbsoluteTimes
+		m.savePrefs()
+		return m, nil
+
+	case key.Matches(msg, m.keys.TogglePin):
+		if item := m.getSelectedItem(); item != nil {
+			m.togglePin(item.ID)
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keys.JumpToItem):
+		m.queueJumpActive = true
+		m.queueJumpInput.SetValue("")
+		m.queueJumpInput.Focus()
 		return m, nil
 
 	case key.Matches(msg, m.keys.Filter):
@@ -449,20 +723,43 @@ func (m Model) handleQueueKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case key.Matches(msg, m.keys.ToggleLanes):
+		m.toggleQueueGrouped()
+		m.ensureQueueVisible()
+		m.prefs.QueueLayout = prefs.QueueLayoutFlat
+		if m.queueGrouped {
+			m.prefs.QueueLayout = prefs.QueueLayoutLanes
+		}
+		m.savePrefs()
+		return m, nil
+
 	case key.Matches(msg, m.keys.Inspect):
+		if m.getSelectedItem() == nil {
+			m.toggleQueueLane()
+			m.ensureQueueVisible()
+			return m, nil
+		}
 		return m.openInspector(tabOverview)
 
 	case key.Matches(msg, m.keys.InspectLogs):
 		return m.openInspector(tabLogs)
 	}
 
-	items := m.getSortedItems()
-	itemCount := len(items)
+	itemCount := len(m.queueRows())
 	if itemCount == 0 {
 		return m, nil
 	}
 
+	m.queueLastNav = m.clock()
 	switch {
+	case key.Matches(msg, m.keys.CollapseLane):
+		if m.queueGrouped {
+			m.toggleQueueLane()
+		}
+	case key.Matches(msg, m.keys.NextLane):
+		m.jumpQueueLane(1)
+	case key.Matches(msg, m.keys.PrevLane):
+		m.jumpQueueLane(-1)
 	case key.Matches(msg, m.keys.Down):
 		if m.selectedRow < itemCount-1 {
 			m.selectedRow++
@@ -491,11 +788,14 @@ func (m Model) handleTick() (tea.Model, tea.Cmd) {
 		m.errorExpiry = time.Time{}
 	}
 
-	// Fetch latest snapshot
-	if m.store != nil {
+	// Fetch latest snapshot unless subscribed to store updates
+	if m.store != nil && m.storeUpdates == nil {
 		cmds = append(cmds, fetchSnapshotCmd(m.store))
 	}
 
+	// An unattended log view drifts back to tailing.
+	m.resumeIdleLogFollow()
+
 	// Skip log fetching when API is offline to reduce error noise
 	if !m.snapshot.IsOffline() {
 		// Daemon log view refresh while following
@@ -505,6 +805,22 @@ func (m Model) handleTick() (tea.Model, tea.Cmd) {
 			}
 		}
 
+		// The watch view tails its item's logs.
+		if !m.inspecting && m.currentView == ViewWatch {
+			if item := m.getWatchedItem(); item != nil {
+				if cmd := m.refreshLogs(item); cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+			}
+		}
+
+		// The health view refreshes its dependency list.
+		if !m.inspecting && m.currentView == ViewHealth {
+			if cmd := m.refreshDependencies(); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
 		// Inspector tabs with live fetches
 		if m.inspecting {
 			if item := m.getInspectedItem(); item != nil {
@@ -567,6 +883,16 @@ func (m Model) renderContent() string {
 		return m.renderLogs()
 	case ViewProblems:
 		return m.renderProblems()
+	case ViewCompleted:
+		return m.renderCompleted()
+	case ViewStats:
+		return m.renderStats()
+	case ViewHealth:
+		return m.renderHealth()
+	case ViewWatch:
+		return m.renderWatch()
+	case ViewReasons:
+		return m.renderReasons()
 	default:
 		return ""
 	}
@@ -600,6 +926,36 @@ func (m Model) handleQueueFilterKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// handleQueueJumpKey handles keys while the jump-to-item input is active.
+// Enter jumps to the typed ID ("42" or "#42").
+func (m Model) handleQueueJumpKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Confirm):
+		m.queueJumpActive = false
+		m.queueJumpInput.Blur()
+		input := strings.TrimPrefix(strings.TrimSpace(m.queueJumpInput.Value()), "#")
+		id, err := strconv.ParseInt(input, 10, 64)
+		switch {
+		case err != nil || id <= 0:
+			m.errorMsg = fmt.Sprintf("Invalid item ID: %q", input)
+			m.errorExpiry = time.Now().Add(5 * time.Second)
+		case !m.jumpToQueueItem(id):
+			m.errorMsg = fmt.Sprintf("Item #%d not found", id)
+			m.errorExpiry = time.Now().Add(5 * time.Second)
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keys.Escape):
+		m.queueJumpActive = false
+		m.queueJumpInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.queueJumpInput, cmd = m.queueJumpInput.Update(msg)
+	return m, cmd
+}
+
 // clearQueueFilter drops the queue text filter entirely.
 func (m *Model) clearQueueFilter() {
 	m.queueFilterActive = false
@@ -621,14 +977,28 @@ func (m Model) helpContext() string {
 	switch m.currentView {
 	case ViewLogs:
 		return "Logs"
-	case ViewProblems:
+	case ViewProblems, ViewCompleted, ViewStats, ViewHealth, ViewWatch, ViewReasons:
 		return "Views"
 	default:
 		return "Queue"
 	}
 }
 
-// spinnerFrames animate the connecting/offline indicator.
+// detailRefreshInterval returns the between-snapshot detail refresh period,
+// zero when disabled.
+func (m Model) detailRefreshInterval() time.Duration {
+	return time.Duration(m.prefs.DetailRefreshMillis) * time.Millisecond
+}
+
+// clock returns the current time from the model clock.
+func (m Model) clock() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+	return time.Now()
+}
+
+// spinnerFrames animate the connecting/offline and fetch indicators.
 var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
 
 // spinnerGlyph returns the current spinner frame.
@@ -637,9 +1007,38 @@ func (m Model) spinnerGlyph() string {
 }
 
 // spinnerActive reports whether the spinner should keep animating: while
-// starting up, or whenever the daemon is unreachable.
+// starting up, whenever the daemon is unreachable, or while a poll is in
+// flight.
 func (m Model) spinnerActive() bool {
-	return !m.ready || !m.snapshot.HasStatus || m.snapshot.IsOffline()
+	return !m.ready || !m.snapshot.HasStatus || m.snapshot.IsOffline() || m.snapshot.Fetching
+}
+
+// applySnapshot installs a new store snapshot and re-renders the views
+// that depend on it, skipping the re-render when the version shows the
+// data is unchanged (a store read racing a pushed update, say).
+func (m *Model) applySnapshot(snap state.Snapshot) tea.Cmd {
+	unchanged := snap.Version != 0 && snap.Version == m.snapshot.Version
+	prev := m.snapshot.Queue
+	prevIDs, prevRow := m.queueRowIDs(), m.selectedRow
+	m.snapshot = snap
+	m.lastUpdated = m.clock()
+	if snap.Endpoint != "" {
+		m.apiEndpoint = snap.Endpoint
+	}
+	if !unchanged {
+		m.etas.Observe(snap.Queue, m.lastUpdated)
+		m.duplicates = state.DuplicateDiscs(snap.Queue)
+		m.reselectQueueItem(prevIDs, prevRow)
+		m.followActiveItem(prev)
+		m.clampProblemsRow()
+		m.refreshInspectorViewport()
+	}
+	// Restart the spinner if the daemon went offline while it was idle.
+	if m.spinnerActive() && !m.spinnerOn {
+		m.spinnerOn = true
+		return spinnerTickCmd()
+	}
+	return nil
 }
 
 // manualRefreshCmds forces an immediate API poll plus a log refresh when a
@@ -674,8 +1073,18 @@ type tickMsg time.Time
 
 type spinnerTickMsg struct{}
 
+// detailTickMsg re-renders the inspector between snapshots.
+type detailTickMsg struct{}
+
 type snapshotMsg state.Snapshot
 
+// storeUpdateMsg is a snapshot pushed by a store subscription.
+type storeUpdateMsg state.Snapshot
+
+// updateAvailableMsg carries the startup update check's result: a newer
+// release version, or "".
+type updateAvailableMsg string
+
 // Commands
 
 func tickCmd(d time.Duration) tea.Cmd {
@@ -690,15 +1099,38 @@ func spinnerTickCmd() tea.Cmd {
 	})
 }
 
+func detailTickCmd(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return detailTickMsg{}
+	})
+}
+
 func fetchSnapshotCmd(store *state.Store) tea.Cmd {
 	return func() tea.Msg {
 		return snapshotMsg(store.Snapshot())
 	}
 }
 
+// waitStoreUpdateCmd blocks for the next subscribed snapshot. A closed
+// subscription yields no message, ending the wait loop.
+func waitStoreUpdateCmd(updates <-chan state.Snapshot) tea.Cmd {
+	return func() tea.Msg {
+		snap, ok := <-updates
+		if !ok {
+			return nil
+		}
+		return storeUpdateMsg(snap)
+	}
+}
+
 // Run starts the Bubble Tea program.
 func Run(opts Options) error {
 	m := New(opts)
+	if m.store != nil {
+		updates, unsubscribe := m.store.Subscribe()
+		defer unsubscribe()
+		m.storeUpdates = updates
+	}
 	p := tea.NewProgram(m)
 	_, err := p.Run()
 	return err
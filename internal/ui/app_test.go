@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTerminalTooSmall_Boundaries(t *testing.T) {
+	tests := []struct {
+		width, height int
+		want          bool
+	}{
+		{minTerminalWidth, minTerminalHeight, false},
+		{200, 60, false},
+		{minTerminalWidth - 1, minTerminalHeight, true},
+		{minTerminalWidth, minTerminalHeight - 1, true},
+		{10, 3, true},
+		{0, 0, true},
+		{-5, -1, true},
+	}
+	for _, tt := range tests {
+		if got := terminalTooSmall(tt.width, tt.height); got != tt.want {
+			t.Errorf("terminalTooSmall(%d, %d) = %v, want %v", tt.width, tt.height, got, tt.want)
+		}
+	}
+}
+
+func TestView_TooSmallShowsNotice(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.ready = true
+	m.width, m.height = 45, 12
+
+	out := stripANSI(m.View().Content)
+	if !strings.Contains(out, "Terminal too small: 45x12 (need 60x15)") {
+		t.Fatalf("View() = %q, want the too-small notice", out)
+	}
+
+	m.width, m.height = minTerminalWidth, minTerminalHeight
+	if out := stripANSI(m.View().Content); strings.Contains(out, "too small") {
+		t.Fatalf("View() at the minimum size should render the UI:\n%s", out)
+	}
+}
@@ -81,8 +81,12 @@ func renderPanel(title, content, footer string, width int, styles Styles) string
 	return b.String()
 }
 
-// chip renders a status badge: theme background color text on a colored fill.
+// chip renders a status badge: theme background color text on a colored fill,
+// or bold reverse video in a monochrome theme.
 func chip(label, colorHex string, theme Theme) string {
+	if theme.Monochrome {
+		return lipgloss.NewStyle().Reverse(true).Bold(true).Padding(0, 1).Render(label)
+	}
 	return lipgloss.NewStyle().
 		Foreground(lipgloss.Color(theme.Background)).
 		Background(lipgloss.Color(colorHex)).
@@ -0,0 +1,298 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/five82/flyer/internal/opener"
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// defaultCompletedWindow is the completed view's look-back when the
+// completed_window_hours pref is unset.
+const defaultCompletedWindow = 24 * time.Hour
+
+// completedSince returns the completed items whose UpdatedAt falls within
+// window of now, newest first (ties to the higher ID). Items without a
+// parseable UpdatedAt are skipped.
+func completedSince(queue []spindle.QueueItem, now time.Time, window time.Duration) []spindle.QueueItem {
+	cutoff := now.Add(-window)
+	var items []spindle.QueueItem
+	for _, item := range queue {
+		if !strings.EqualFold(item.Stage, "completed") {
+			continue
+		}
+		updated := item.ParsedUpdatedAt()
+		if updated.IsZero() || updated.Before(cutoff) {
+			continue
+		}
+		items = append(items, item)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		ui, uj := items[i].ParsedUpdatedAt(), items[j].ParsedUpdatedAt()
+		if !ui.Equal(uj) {
+			return ui.After(uj)
+		}
+		return items[i].ID > items[j].ID
+	})
+	return items
+}
+
+// completedWindow returns the completed view's look-back.
+func (m Model) completedWindow() time.Duration {
+	if h := m.prefs.CompletedWindowHours; h > 0 {
+		return time.Duration(h) * time.Hour
+	}
+	return defaultCompletedWindow
+}
+
+// getCompletedItems returns the items the completed view lists.
+func (m *Model) getCompletedItems() []spindle.QueueItem {
+	return completedSince(m.snapshot.Queue, m.clock(), m.completedWindow())
+}
+
+// getCompletedItem returns the selected completed item.
+func (m *Model) getCompletedItem() *spindle.QueueItem {
+	items := m.getCompletedItems()
+	if m.completedRow < 0 || m.completedRow >= len(items) {
+		return nil
+	}
+	return &items[m.completedRow]
+}
+
+// clampCompletedRow keeps the completed selection within bounds.
+func (m *Model) clampCompletedRow() {
+	if count := len(m.getCompletedItems()); m.completedRow >= count {
+		m.completedRow = max(count-1, 0)
+	}
+}
+
+// completedVisibleRows returns the item rows available to the completed
+// panel. Fixed chrome: header band, panel borders, column header, footer
+// band.
+func (m *Model) completedVisibleRows() int {
+	return max(m.height-5, 1)
+}
+
+// completedColumns holds the completed table's column widths.
+type completedColumns struct {
+	id    int
+	title int
+	file  int
+	saved int
+	speed int
+	ago   int
+}
+
+// computeCompletedColumns sizes the completed table: fixed numeric columns,
+// with the title and final file splitting the rest of the panel interior.
+func computeCompletedColumns(items []spindle.QueueItem, width int) completedColumns {
+	cols := completedColumns{id: 2, saved: 5, speed: 6, ago: 8}
+	for _, item := range items {
+		cols.id = max(cols.id, len(fmt.Sprintf("#%d", item.ID)))
+	}
+	// Fixed columns plus 2-space separators between all six columns.
+	rest := panelInnerWidth(width) - (cols.id + cols.saved + cols.speed + cols.ago + 10)
+	cols.title = max(rest/2, 10)
+	cols.file = max(rest-cols.title, 10)
+	return cols
+}
+
+// renderCompleted renders the recently completed items as a Level 1 panel:
+// title, final file, size reduction and encode speed.
+func (m Model) renderCompleted() string {
+	styles := m.theme.Styles()
+	visibleRows := m.completedVisibleRows()
+
+	items := m.getCompletedItems()
+	cols := computeCompletedColumns(items, m.width)
+	lines := []string{styles.FaintText.Render(completedRowText(cols, "ID", "TITLE", "FILE", "SAVED", "SPEED", "DONE"))}
+
+	footer := ""
+	if len(items) == 0 {
+		lines = append(lines, styles.MutedText.Render("Nothing completed in the "+m.completedWindowLabel()))
+	} else {
+		scroll := clampQueueScroll(m.completedScroll, m.completedRow, visibleRows, len(items))
+		end := min(scroll+visibleRows, len(items))
+		for i := scroll; i < end; i++ {
+			lines = append(lines, m.renderCompletedRow(items[i], cols, i == m.completedRow, styles))
+		}
+		footer = scrollRangeFooter(scroll, end, len(items), visibleRows)
+	}
+	for len(lines) < visibleRows+1 {
+		lines = append(lines, "")
+	}
+
+	title := fmt.Sprintf("Completed (%d) · %s", len(items), m.completedWindowLabel())
+	return renderPanel(title, strings.Join(lines, "\n"), footer, m.width, styles)
+}
+
+// completedWindowLabel describes the look-back, e.g. "last 24h".
+func (m Model) completedWindowLabel() string {
+	return fmt.Sprintf("last %dh", int(m.completedWindow().Hours()))
+}
+
+// renderCompletedRow renders one completed item row.
+func (m Model) renderCompletedRow(item spindle.QueueItem, cols completedColumns, selected bool, styles Styles) string {
+	saved, speed := "", ""
+	if enc := item.Encoding; enc != nil {
+		if enc.SizeReductionPercent > 0 {
+			saved = fmt.Sprintf("%.0f%%", enc.SizeReductionPercent)
+		}
+		if enc.AverageSpeed > 0 {
+			speed = fmt.Sprintf("%.1fx", enc.AverageSpeed)
+		}
+	}
+	ago := ""
+	if updated := item.ParsedUpdatedAt(); !updated.IsZero() {
+		ago = humanizeDuration(m.clock().Sub(updated))
+	}
+
+	line := completedRowText(cols, fmt.Sprintf("#%d", item.ID), item.FullTitle(), completedFile(item), saved, speed, ago)
+	if selected {
+		if n := panelInnerWidth(m.width) - lipgloss.Width(line); n > 0 {
+			line += strings.Repeat(" ", n)
+		}
+		return styles.Selected.Render(line)
+	}
+	return styles.Text.Render(line)
+}
+
+// completedRowText lays out one completed table line, truncating and
+// padding each cell to its column.
+func completedRowText(cols completedColumns, id, title, file, saved, speed, ago string) string {
+	cell := func(s string, w int) string {
+		s = truncate(s, w)
+		if n := w - lipgloss.Width(s); n > 0 {
+			s += strings.Repeat(" ", n)
+		}
+		return s
+	}
+	return strings.Join([]string{
+		cell(id, cols.id),
+		cell(title, cols.title),
+		cell(file, cols.file),
+		cell(saved, cols.saved),
+		cell(speed, cols.speed),
+		cell(ago, cols.ago),
+	}, "  ")
+}
+
+// completedFile names where a completed item landed: the final file's base
+// name, or a file count for multi-episode items.
+func completedFile(item spindle.QueueItem) string {
+	episodes, _ := item.EpisodeSnapshot()
+	var paths []string
+	for _, ep := range episodes {
+		if p := strings.TrimSpace(ep.FinalPath); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	switch len(paths) {
+	case 0:
+		return ""
+	case 1:
+		return filepath.Base(paths[0])
+	default:
+		return fmt.Sprintf("%d files in %s", len(paths), filepath.Base(filepath.Dir(paths[0])))
+	}
+}
+
+// itemOutputPath returns the item's output to open: its final file, the
+// directory holding several final files, or the encoded file(s) before
+// the final move. Empty when nothing has been produced yet.
+func itemOutputPath(item spindle.QueueItem) string {
+	episodes, _ := item.EpisodeSnapshot()
+	for _, pick := range []func(spindle.EpisodeStatus) string{
+		func(ep spindle.EpisodeStatus) string { return ep.FinalPath },
+		func(ep spindle.EpisodeStatus) string { return ep.EncodedPath },
+	} {
+		var paths []string
+		for _, ep := range episodes {
+			if p := strings.TrimSpace(pick(ep)); p != "" {
+				paths = append(paths, p)
+			}
+		}
+		switch len(paths) {
+		case 0:
+			continue
+		case 1:
+			return paths[0]
+		default:
+			return filepath.Dir(paths[0])
+		}
+	}
+	return ""
+}
+
+// openOutput opens item's output with the desktop's default application,
+// returning a status message for the header. Paths from a remote daemon
+// don't exist here, so those are reported instead of opened.
+func (m Model) openOutput(item *spindle.QueueItem) string {
+	if item == nil {
+		return "No item selected"
+	}
+	path := itemOutputPath(*item)
+	switch {
+	case path == "":
+		return fmt.Sprintf("#%d has no output file yet", item.ID)
+	case m.opener == nil:
+		return "Opening files is unavailable"
+	case !opener.IsLocalEndpoint(m.apiEndpoint):
+		return "Spindle is remote; open " + path + " on its host"
+	}
+	if err := m.opener.Open(path); err != nil {
+		return "Open failed: " + err.Error()
+	}
+	return "Opened " + filepath.Base(path)
+}
+
+// handleCompletedKey processes keyboard input for the completed view.
+func (m Model) handleCompletedKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Inspect):
+		return m.openInspector(tabOverview)
+
+	case key.Matches(msg, m.keys.InspectLogs):
+		return m.openInspector(tabLogs)
+
+	case key.Matches(msg, m.keys.OpenOutput):
+		m.errorMsg = m.openOutput(m.getCompletedItem())
+		m.errorExpiry = time.Now().Add(5 * time.Second)
+		return m, nil
+
+	case key.Matches(msg, m.keys.Escape):
+		m.currentView = ViewQueue
+		return m, nil
+	}
+
+	items := m.getCompletedItems()
+	if len(items) == 0 {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Down):
+		if m.completedRow < len(items)-1 {
+			m.completedRow++
+		}
+	case key.Matches(msg, m.keys.Up):
+		if m.completedRow > 0 {
+			m.completedRow--
+		}
+	case key.Matches(msg, m.keys.Top):
+		m.completedRow = 0
+	case key.Matches(msg, m.keys.Bottom):
+		m.completedRow = len(items) - 1
+	}
+	m.completedScroll = clampQueueScroll(m.completedScroll, m.completedRow, m.completedVisibleRows(), len(items))
+
+	return m, nil
+}
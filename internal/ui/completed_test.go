@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/five82/flyer/internal/prefs"
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
+)
+
+func TestCompletedSince_FiltersWindowAndOrdersNewestFirst(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+	queue := []spindle.QueueItem{
+		{ID: 1, Stage: "completed", UpdatedAt: at(2 * time.Hour)},
+		{ID: 2, Stage: "completed", UpdatedAt: at(30 * time.Hour)}, // outside the window
+		{ID: 3, Stage: "encoding", UpdatedAt: at(time.Hour)},       // not completed
+		{ID: 4, Stage: "Completed", UpdatedAt: at(time.Hour)},
+		{ID: 5, Stage: "completed"},                               // no timestamp
+		{ID: 6, Stage: "completed", UpdatedAt: at(2 * time.Hour)}, // ties go to the higher ID
+	}
+
+	got := completedSince(queue, now, 24*time.Hour)
+	var ids []int64
+	for _, item := range got {
+		ids = append(ids, item.ID)
+	}
+	want := []int64{4, 6, 1}
+	if len(ids) != len(want) {
+		t.Fatalf("completedSince() ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("completedSince() ids = %v, want %v", ids, want)
+		}
+	}
+
+	if got := completedSince(queue, now, 48*time.Hour); len(got) != 4 {
+		t.Fatalf("completedSince() with 48h window returned %d items, want 4", len(got))
+	}
+}
+
+func TestRenderCompleted_ShowsResultColumns(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	m := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{CompletedWindowHours: 12}})
+	m.now = func() time.Time { return now }
+	m.width, m.height = 120, 20
+	m.snapshot = state.Snapshot{Queue: []spindle.QueueItem{{
+		ID:           7,
+		Stage:        "completed",
+		DisplayTitle: "Alien",
+		UpdatedAt:    now.Add(-time.Hour).Format(time.RFC3339),
+		Encoding:     &spindle.EncodingStatus{SizeReductionPercent: 42, AverageSpeed: 3.25},
+		Episodes:     []spindle.EpisodeStatus{{Key: "main", FinalPath: "/library/movies/Alien (1979).mkv"}},
+	}}}
+
+	got := stripANSI(m.renderCompleted())
+	for _, want := range []string{"Completed (1) · last 12h", "Alien (1979).mkv", "42%", "3.2x", "1h ago"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("renderCompleted() missing %q:\n%s", want, got)
+		}
+	}
+}
+
+// fakeOpener records the paths it is asked to open.
+type fakeOpener struct {
+	paths []string
+	err   error
+}
+
+func (f *fakeOpener) Open(path string) error {
+	f.paths = append(f.paths, path)
+	return f.err
+}
+
+func TestItemOutputPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		episodes []spindle.EpisodeStatus
+		want     string
+	}{
+		{"nothing yet", []spindle.EpisodeStatus{{RippedPath: "/rips/a.mkv"}}, ""},
+		{"encoded only", []spindle.EpisodeStatus{{EncodedPath: "/enc/Heat.mkv"}}, "/enc/Heat.mkv"},
+		{"final wins", []spindle.EpisodeStatus{{EncodedPath: "/enc/Heat.mkv", FinalPath: "/lib/Heat (1995).mkv"}}, "/lib/Heat (1995).mkv"},
+		{"several finals", []spindle.EpisodeStatus{{FinalPath: "/tv/Show/S01E01.mkv"}, {FinalPath: "/tv/Show/S01E02.mkv"}}, "/tv/Show"},
+	}
+	for _, tt := range tests {
+		if got := itemOutputPath(spindle.QueueItem{Episodes: tt.episodes}); got != tt.want {
+			t.Errorf("%s: itemOutputPath = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOpenOutput_LocalAndRemote(t *testing.T) {
+	item := spindle.QueueItem{ID: 5, Stage: "completed", Episodes: []spindle.EpisodeStatus{{FinalPath: "/lib/Heat.mkv"}}}
+
+	fake := &fakeOpener{}
+	m := New(Options{ThemeName: "slate", APIEndpoint: "127.0.0.1:7487", Opener: fake})
+	if msg := m.openOutput(&item); msg != "Opened Heat.mkv" || len(fake.paths) != 1 || fake.paths[0] != "/lib/Heat.mkv" {
+		t.Fatalf("local: msg %q, opened %q", msg, fake.paths)
+	}
+
+	fake = &fakeOpener{}
+	m = New(Options{ThemeName: "slate", APIEndpoint: "http://server:7487", Opener: fake})
+	if msg := m.openOutput(&item); !strings.Contains(msg, "remote") || len(fake.paths) != 0 {
+		t.Fatalf("remote: msg %q, opened %q; want a message and no open", msg, fake.paths)
+	}
+
+	if msg := m.openOutput(&spindle.QueueItem{ID: 6}); msg != "#6 has no output file yet" {
+		t.Fatalf("no output: msg %q", msg)
+	}
+}
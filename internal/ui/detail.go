@@ -49,8 +49,9 @@ func (w fieldWriter) fieldStyled(label string, labelStyle lipgloss.Style, value
 //
 //	Attention review/error/warning details (only when something needs the operator)
 //	Pipeline  scheduler task board
-//	Media     source, video, audio, crop, encoder config, identification
-//	Output    size estimate/result, encode stats, validation, subtitles, path
+//	Media     source, crop, encoder config, identification
+//	Tracks    video, audio, commentary, subtitle sources
+//	Output    size estimate/result, encode stats, validation, path
 //	Episodes  batch summary (full list lives on the Episodes tab)
 //	Meta      absolute timestamps (faint footer; the item band carries the age)
 func (m *Model) renderDetailContent(item spindle.QueueItem, width int) string {
@@ -67,6 +68,7 @@ func (m *Model) renderDetailContent(item spindle.QueueItem, width int) string {
 	m.renderTaskBoard(&b, item, styles, width)
 
 	m.renderMedia(w, item, styles)
+	m.renderTracks(w, item, styles)
 	m.renderOutput(w, item, styles)
 	m.renderEpisodeSummarySection(&b, item, styles)
 
@@ -95,6 +97,34 @@ func (m *Model) renderDetailMeta(b *strings.Builder, item spindle.QueueItem, sty
 	b.WriteString("\n")
 }
 
+// stalledThreshold is how long running work may go without an item update
+// before the STALLED badge shows.
+const stalledThreshold = 10 * time.Minute
+
+// detailFooterStats summarizes an item for the Overview panel footer:
+// stage, lane, and the running task's percent and ETA, plus episode
+// progress for multi-episode items ("encoding · Running · 42% · ETA 12m ·
+// 3/8 eps").
+func (m Model) detailFooterStats(item spindle.QueueItem) string {
+	item = m.etas.Apply(item)
+	stage, _ := queueStageCell(item, m.theme.Styles())
+	parts := []string{stage, itemLane(item)}
+
+	_, totals := item.EpisodeSnapshot()
+	if task := item.PrimaryTask(); task != nil && task.IsRunning() {
+		if pct := taskPercent(item, *task); pct > 0 {
+			parts = append(parts, fmt.Sprintf("%.0f%%", clampPercent(pct)))
+		}
+		if eta := taskRemaining(item, *task, totals, m.clock()); eta > 0 {
+			parts = append(parts, m.formatETA(eta))
+		}
+	}
+	if totals.Planned > 1 {
+		parts = append(parts, fmt.Sprintf("%d/%d eps", totals.Final, totals.Planned))
+	}
+	return strings.Join(parts, " · ")
+}
+
 // renderStatusChips renders the status badges for an item.
 func (m *Model) renderStatusChips(item spindle.QueueItem, styles Styles) string {
 	var chips []string
@@ -110,7 +140,7 @@ func (m *Model) renderStatusChips(item spindle.QueueItem, styles Styles) string
 	chips = append(chips, roleStyle(info.role, styles).Bold(true).Render(strings.ToUpper(label)))
 
 	// Media type chip
-	if mediaType := detectMediaType(item.Metadata); mediaType != "" {
+	if mediaType := detectMediaType(item); mediaType != "" {
 		label := "MOVIE"
 		if mediaType == "tv" {
 			label = "TV"
@@ -133,6 +163,16 @@ func (m *Model) renderStatusChips(item spindle.QueueItem, styles Styles) string
 		chips = append(chips, chip("STOPPED", m.theme.Muted, m.theme))
 	}
 
+	// Stalled badge: running work with no update for stalledThreshold.
+	if !item.UserStopped && item.IsStalled(stalledThreshold) {
+		chips = append(chips, chip("STALLED", m.theme.Warning, m.theme))
+	}
+
+	// DUP badge: another queue item ripped the same disc.
+	if len(m.duplicates[item.ID]) > 0 {
+		chips = append(chips, chip("DUP", m.theme.Warning, m.theme))
+	}
+
 	// CACHE badge (rip cache hit, reported via the ripping task's message)
 	if isRipCacheHit(item) {
 		chips = append(chips, chip("CACHE", m.theme.Info, m.theme))
@@ -267,17 +307,20 @@ func (m *Model) renderMedia(w fieldWriter, item spindle.QueueItem, styles Styles
 	inner := fieldWriter{b: &b, styles: w.styles, width: w.width}
 
 	inner.field("Source", sourceSummary(item.Source), styles.Text)
-	renderVideoSpecs(inner, item)
-	renderAudioInfo(inner, item)
-	if item.CommentaryCount > 0 {
-		inner.field("Comment.", fmt.Sprintf("%d commentary track(s) detected", item.CommentaryCount), styles.Text)
+	if disc := discSummary(item, m.duplicates[item.ID]); disc != "" {
+		discStyle := styles.FaintText
+		if len(m.duplicates[item.ID]) > 0 {
+			discStyle = styles.WarningText
+		}
+		inner.field("Disc", disc, discStyle)
 	}
 	renderCropInfo(inner, item)
 	renderEncodingConfig(inner, item)
 	renderContentID(inner, item)
 
 	// Identification metadata (year, ids, ...) when present.
-	for _, r := range summarizeMetadata(item.Metadata) {
+	meta, _ := item.ParseMetadata()
+	for _, r := range summarizeMetadata(meta) {
 		label := metadataFieldLabel(r.key)
 		if label == "" {
 			continue
@@ -292,6 +335,26 @@ func (m *Model) renderMedia(w fieldWriter, item spindle.QueueItem, styles Styles
 	w.b.WriteString(b.String())
 }
 
+// renderTracks renders the track block: video resolution and dynamic
+// range, source and encoded audio, commentary, and subtitle sources.
+func (m *Model) renderTracks(w fieldWriter, item spindle.QueueItem, styles Styles) {
+	var b strings.Builder
+	inner := fieldWriter{b: &b, styles: w.styles, width: w.width}
+
+	renderVideoSpecs(inner, item)
+	renderAudioInfo(inner, item)
+	if item.CommentaryCount > 0 {
+		inner.field("Comment.", fmt.Sprintf("%d commentary track(s) detected", item.CommentaryCount), styles.Text)
+	}
+	renderSubtitleSummary(inner, item)
+
+	if b.Len() == 0 {
+		return
+	}
+	m.writeSection(w.b, "Tracks", styles, w.width)
+	w.b.WriteString(b.String())
+}
+
 // metadataFieldLabel maps a metadata key to a compact row label. Returns ""
 // for keys already carried by the item line and chips.
 func metadataFieldLabel(key string) string {
@@ -319,7 +382,6 @@ func (m *Model) renderOutput(w fieldWriter, item spindle.QueueItem, styles Style
 	renderSizeResult(inner, item)
 	renderEncodeStats(inner, item)
 	renderValidationSummary(inner, item)
-	renderSubtitleSummary(inner, item)
 	renderFinalPath(inner, item)
 	if !strings.EqualFold(item.Stage, "failed") {
 		inner.field("Files", m.describeItemFileStates(item), styles.Text)
@@ -336,7 +398,7 @@ func (m *Model) renderOutput(w fieldWriter, item spindle.QueueItem, styles Style
 // per-episode list lives on the Episodes tab.
 func (m *Model) renderEpisodeSummarySection(b *strings.Builder, item spindle.QueueItem, styles Styles) {
 	episodes, totals := item.EpisodeSnapshot()
-	if len(episodes) <= 1 && detectMediaType(item.Metadata) != "tv" {
+	if len(episodes) <= 1 && detectMediaType(item) != "tv" {
 		return
 	}
 
@@ -350,6 +412,28 @@ func (m *Model) renderEpisodeSummarySection(b *strings.Builder, item spindle.Que
 	b.WriteString("\n")
 }
 
+// discFingerprintLen is how much of a disc fingerprint the Overview shows;
+// enough to tell discs apart at a glance.
+const discFingerprintLen = 12
+
+// discSummary renders the item's short disc fingerprint, naming the other
+// items that share it ("3f9a0c2e71bd · same disc as #4, #9").
+func discSummary(item spindle.QueueItem, duplicates []int64) string {
+	fp := strings.TrimSpace(item.DiscFingerprint)
+	if fp == "" {
+		return ""
+	}
+	value := fp[:min(len(fp), discFingerprintLen)]
+	if len(duplicates) > 0 {
+		ids := make([]string, len(duplicates))
+		for i, id := range duplicates {
+			ids[i] = fmt.Sprintf("#%d", id)
+		}
+		value += " · same disc as " + strings.Join(ids, ", ")
+	}
+	return value
+}
+
 // sourceSummary formats a movie's primary source title, e.g.
 // "Title 02 (118m)". Returns "" when no source info is available.
 func sourceSummary(src *spindle.SourceTitle) string {
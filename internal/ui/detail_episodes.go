@@ -50,9 +50,18 @@ func (m *Model) isEpisodesCollapsed(item spindle.QueueItem, episodes []spindle.E
 	if ok {
 		return collapsed
 	}
+	if m.prefs.AutoExpandActiveEpisodes && isActiveEpisodeItem(item, episodes) {
+		return false
+	}
 	return !shouldAutoExpandEpisodes(item, episodes, totals)
 }
 
+// isActiveEpisodeItem reports whether a multi-episode item has running
+// work, for the auto_expand_active_episodes preference.
+func isActiveEpisodeItem(item spindle.QueueItem, episodes []spindle.EpisodeStatus) bool {
+	return len(episodes) > 1 && isProcessingItem(item)
+}
+
 func shouldAutoExpandEpisodes(item spindle.QueueItem, episodes []spindle.EpisodeStatus, totals spindle.EpisodeTotals) bool {
 	if len(episodes) <= 8 {
 		return true
@@ -140,6 +149,12 @@ func (m *Model) renderEpisodeRow(b *strings.Builder, item spindle.QueueItem, ep
 	b.WriteString(grid)
 	b.WriteString(" ")
 	b.WriteString(titleStyle.Render(title))
+	if active && !ep.IsFailed() {
+		pct := episodeProgress(item, ep)
+		b.WriteString("  ")
+		b.WriteString(renderProgressBar(pct, 8, styles.AccentText, styles))
+		b.WriteString(styles.MutedText.Render(fmt.Sprintf(" %.0f%%", pct)))
+	}
 	b.WriteString("\n")
 
 	if meta := compactEpisodeMeta(describeEpisodeTrackInfo(&ep), describeEpisodeMapping(ep), extras); meta != "" {
@@ -166,6 +181,22 @@ func (m *Model) renderEpisodeRow(b *strings.Builder, item spindle.QueueItem, ep
 	}
 }
 
+// episodeProgress returns an episode's progress: its stage baseline, with
+// the item's encode percent filling the ripped-to-encoded span while a
+// running encoding task works on this episode.
+func episodeProgress(item spindle.QueueItem, ep spindle.EpisodeStatus) float64 {
+	base := ep.ProgressPercent()
+	if base != 25 {
+		return base
+	}
+	for _, t := range item.Tasks {
+		if t.IsRunning() && strings.EqualFold(t.Type, "encoding") && strings.EqualFold(t.ActiveAssetKey, ep.Key) {
+			return base + item.Encoding.OverallPercent()/4
+		}
+	}
+	return base
+}
+
 // describeEpisodeWithExtras returns title and extra info (runtime, language, source).
 func describeEpisodeWithExtras(ep spindle.EpisodeStatus) (string, []string) {
 	title := episodeDisplayTitle(ep)
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/five82/flyer/internal/prefs"
 	"github.com/five82/flyer/internal/spindle"
 )
 
@@ -120,6 +121,27 @@ func TestActiveEpisodeIndex(t *testing.T) {
 	}
 }
 
+func TestEpisodeProgress_BlendsEncodePercent(t *testing.T) {
+	ep := spindle.EpisodeStatus{Key: "s01e02", Stage: "ripped"}
+	item := spindle.QueueItem{
+		Tasks:    []spindle.Task{{Type: "encoding", State: "running", ActiveAssetKey: "S01E02"}},
+		Encoding: &spindle.EncodingStatus{Percent: 60},
+	}
+	if got := episodeProgress(item, ep); got != 40 {
+		t.Fatalf("episodeProgress() = %v, want 40 (25 + 60%% of the encode span)", got)
+	}
+
+	other := spindle.EpisodeStatus{Key: "s01e03", Stage: "ripped"}
+	if got := episodeProgress(item, other); got != 25 {
+		t.Fatalf("episodeProgress() = %v, want 25 for an episode not being encoded", got)
+	}
+
+	done := spindle.EpisodeStatus{Key: "s01e02", Stage: "encoded"}
+	if got := episodeProgress(item, done); got != 50 {
+		t.Fatalf("episodeProgress() = %v, want 50 once encoded", got)
+	}
+}
+
 func TestDescribeEpisodeHelpers(t *testing.T) {
 	ep := spindle.EpisodeStatus{MatchScore: 0.93}
 	if got := describeEpisodeMapping(ep); got != "Match 0.93" {
@@ -231,3 +253,46 @@ func TestDescribeItemFileStates_DerivesTotals(t *testing.T) {
 		t.Fatalf("describeItemFileStates() = %q, want %q", got, "RIP ENC SUB FIN")
 	}
 }
+
+func TestIsActiveEpisodeItem(t *testing.T) {
+	running := []spindle.Task{{Type: "encoding", State: "running"}}
+	tests := []struct {
+		name     string
+		item     spindle.QueueItem
+		episodes int
+		want     bool
+	}{
+		{"running multi-episode", spindle.QueueItem{Tasks: running}, 10, true},
+		{"idle multi-episode", spindle.QueueItem{Stage: "encoding"}, 10, false},
+		{"running single episode", spindle.QueueItem{Tasks: running}, 1, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isActiveEpisodeItem(tc.item, make([]spindle.EpisodeStatus, tc.episodes)); got != tc.want {
+				t.Fatalf("isActiveEpisodeItem() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsEpisodesCollapsed_AutoExpandActivePref(t *testing.T) {
+	item := spindle.QueueItem{ID: 1, Tasks: []spindle.Task{{Type: "encoding", State: "running"}}}
+	episodes := make([]spindle.EpisodeStatus, 10)
+	totals := spindle.EpisodeTotals{Planned: 10}
+
+	m := New(Options{ThemeName: "slate"})
+	if !m.isEpisodesCollapsed(item, episodes, totals) {
+		t.Fatal("large active set without the pref should stay collapsed")
+	}
+
+	m = New(Options{ThemeName: "slate", Prefs: prefs.Prefs{AutoExpandActiveEpisodes: true}})
+	if m.isEpisodesCollapsed(item, episodes, totals) {
+		t.Fatal("active item should auto-expand with the pref on")
+	}
+
+	// An explicit toggle still wins over the pref.
+	m.detailState.episodeCollapsed[item.ID] = true
+	if !m.isEpisodesCollapsed(item, episodes, totals) {
+		t.Fatal("explicit collapse should override auto-expand")
+	}
+}
@@ -1,11 +1,13 @@
 package ui
 
 import (
-	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/five82/flyer/internal/spindle"
 )
 
 // metadataRow represents a single metadata key-value pair.
@@ -34,51 +36,69 @@ func formatTimestamp(t time.Time, now time.Time) string {
 
 // humanizeDuration formats a duration as relative time (e.g., "5m ago").
 func humanizeDuration(d time.Duration) string {
-	if d < 0 {
-		d = 0
-	}
 	if d < time.Minute {
 		return "just now"
 	}
-	if d < time.Hour {
-		return fmt.Sprintf("%dm ago", int(d.Minutes()))
-	}
-	if d < 24*time.Hour {
-		return fmt.Sprintf("%dh ago", int(d.Hours()))
-	}
-	return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	return humanizeSpan(d, time.Minute, 1) + " ago"
 }
 
-// humanizeDurationLong formats duration as "Xh Ym".
-func humanizeDurationLong(d time.Duration) string {
-	if d <= 0 {
-		return ""
-	}
-	h := int(d.Hours())
-	m := int(d.Minutes()) % 60
-
-	if h > 0 {
-		return fmt.Sprintf("%dh %dm", h, m)
-	}
-	return fmt.Sprintf("%dm", m)
+// spanUnits lists the components humanizeSpan can emit, largest first.
+var spanUnits = []struct {
+	size   time.Duration
+	suffix string
+}{
+	{24 * time.Hour, "d"},
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
 }
 
-// formatDuration formats a duration with hours, minutes, and seconds.
-func formatDuration(d time.Duration) string {
+// humanizeSpan is the shared duration formatter ("2d 3h", "1h 20m",
+// "4m 5s"). It emits at most parts components,
+// starting at the largest non-zero one and never finer than unit, and
+// rounds to the smallest component shown. Non-positive durations return
+// "", and spans shorter than half a unit render as "<1" + unit suffix.
+func humanizeSpan(d time.Duration, unit time.Duration, parts int) string {
 	if d <= 0 {
 		return ""
 	}
-	h := int(d.Hours())
-	m := int(d.Minutes()) % 60
-	s := int(d.Seconds()) % 60
-
-	if h > 0 {
-		return fmt.Sprintf("%dh %dm %ds", h, m, s)
+	if parts < 1 {
+		parts = 1
+	}
+	first := len(spanUnits) - 1
+	last := first
+	for i, u := range spanUnits {
+		if u.size == unit {
+			last = i
+			break
+		}
 	}
-	if m > 0 {
-		return fmt.Sprintf("%dm %ds", m, s)
+	// Rounding can carry into a larger unit (59m40s -> 1h), so pick the
+	// leading component after rounding and settle within two passes.
+	rounded := d
+	for range 2 {
+		first = last
+		for i := 0; i < last; i++ {
+			if rounded >= spanUnits[i].size {
+				first = i
+				break
+			}
+		}
+		smallest := min(first+parts-1, last)
+		rounded = d.Round(spanUnits[smallest].size)
 	}
-	return fmt.Sprintf("%ds", s)
+	if rounded < spanUnits[last].size {
+		return "<1" + spanUnits[last].suffix
+	}
+
+	var out []string
+	for i := first; i <= min(first+parts-1, last); i++ {
+		u := spanUnits[i]
+		n := rounded / u.size
+		rounded -= n * u.size
+		out = append(out, fmt.Sprintf("%d%s", n, u.suffix))
+	}
+	return strings.Join(out, " ")
 }
 
 // formatBytes formats bytes as human-readable size (GiB/MiB).
@@ -112,77 +132,43 @@ func formatRuntime(seconds int) string {
 	return fmt.Sprintf("%dm", seconds/60)
 }
 
-// detectMediaType extracts the media type from metadata JSON.
-func detectMediaType(raw json.RawMessage) string {
-	if len(raw) == 0 {
-		return ""
-	}
-	var obj map[string]any
-	if err := json.Unmarshal(raw, &obj); err != nil {
-		return ""
-	}
-	for _, key := range []string{"media_type", "type"} {
-		if v, ok := obj[key]; ok {
-			if s, ok := v.(string); ok {
-				return strings.ToLower(strings.TrimSpace(s))
-			}
-		}
-	}
-	return ""
+// detectMediaType returns the item's lowercase media type ("movie", "tv"),
+// empty when unknown.
+func detectMediaType(item spindle.QueueItem) string {
+	meta, _ := item.ParseMetadata()
+	return meta.MediaType
 }
 
-// metadataYear extracts the release year from metadata JSON. Spindle
-// stores it as a string; a number is tolerated for safety.
-func metadataYear(raw json.RawMessage) string {
-	if len(raw) == 0 {
-		return ""
-	}
-	var obj map[string]any
-	if err := json.Unmarshal(raw, &obj); err != nil {
-		return ""
-	}
-	switch v := obj["year"].(type) {
-	case string:
-		return strings.TrimSpace(v)
-	case float64:
-		return fmt.Sprintf("%.0f", v)
-	}
-	return ""
+// metadataYear returns the item's release year, empty when unknown.
+func metadataYear(item spindle.QueueItem) string {
+	meta, _ := item.ParseMetadata()
+	return meta.Year
 }
 
-// summarizeMetadata extracts displayable metadata rows from JSON.
-func summarizeMetadata(raw json.RawMessage) []metadataRow {
-	if len(raw) == 0 {
-		return nil
-	}
-	var obj map[string]any
-	if err := json.Unmarshal(raw, &obj); err != nil || len(obj) == 0 {
-		return nil
+// summarizeMetadata extracts displayable metadata rows. Identity fields
+// (title, year, media type) and the overview are left to the callers that
+// show them.
+func summarizeMetadata(meta spindle.Metadata) []metadataRow {
+	var rows []metadataRow
+	if meta.TMDBID > 0 {
+		rows = append(rows, metadataRow{key: "id", value: strconv.FormatInt(meta.TMDBID, 10)})
 	}
-	mediaType := ""
-	if mt, ok := obj["media_type"]; ok {
-		if s, ok := mt.(string); ok {
-			mediaType = strings.ToLower(strings.TrimSpace(s))
-		}
+	if meta.SeasonNumber > 0 && meta.MediaType != "movie" {
+		rows = append(rows, metadataRow{key: "season_number", value: strconv.Itoa(meta.SeasonNumber)})
 	}
 	skip := map[string]struct{}{
 		"vote_average": {},
 		"vote_count":   {},
-		"overview":     {},
 	}
-	var rows []metadataRow
-	for k, val := range obj {
+	for k, val := range meta.Extra {
 		lk := strings.ToLower(strings.TrimSpace(k))
 		if _, ignore := skip[lk]; ignore {
 			continue
 		}
-		if mediaType == "movie" && lk == "movie" {
-			continue
-		}
-		if mediaType == "tv" && lk == "tv" {
+		if meta.MediaType == "movie" && lk == "movie" {
 			continue
 		}
-		if mediaType == "movie" && strings.EqualFold(k, "season_number") {
+		if meta.MediaType == "tv" && lk == "tv" {
 			continue
 		}
 		switch v := val.(type) {
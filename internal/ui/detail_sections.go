@@ -3,6 +3,8 @@ package ui
 import (
 	"fmt"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,7 +19,7 @@ func renderEstimatedSize(w fieldWriter, item spindle.QueueItem) {
 		return
 	}
 	// Only show after 10% progress for accuracy
-	if enc.Percent < 10 {
+	if enc.OverallPercent() < 10 {
 		return
 	}
 	if enc.EstimatedTotalBytes <= 0 {
@@ -72,9 +74,29 @@ func renderVideoSpecs(w fieldWriter, item spindle.QueueItem) {
 	w.field("Video", strings.Join(parts, " "), w.styles.AccentText)
 }
 
-// renderAudioInfo renders the source audio format.
+// renderAudioInfo renders the source audio format and, once the encode
+// config is known, the codec it is encoded to.
 func renderAudioInfo(w fieldWriter, item spindle.QueueItem) {
-	w.field("Audio", item.PrimaryAudioDescription, w.styles.Text)
+	w.field("Audio", audioTrackSummary(item), w.styles.Text)
+}
+
+// audioTrackSummary describes the primary audio track: the source
+// description, then "-> codec" when the encoding config names one. Either
+// part alone is shown as-is; nil Encoding leaves just the source.
+func audioTrackSummary(item spindle.QueueItem) string {
+	source := strings.TrimSpace(item.PrimaryAudioDescription)
+	var codec string
+	if item.Encoding != nil {
+		codec = strings.TrimSpace(item.Encoding.AudioCodec)
+	}
+	switch {
+	case source != "" && codec != "":
+		return source + " -> " + codec
+	case codec != "":
+		return codec
+	default:
+		return source
+	}
 }
 
 // renderEncodingConfig renders the encoding config line
@@ -134,7 +156,8 @@ func renderContentID(w fieldWriter, item spindle.QueueItem) {
 	}
 }
 
-// renderEncodeStats renders duration and average speed (for completed).
+// renderEncodeStats renders duration, average speed, and the realtime
+// factor against the source runtime (for completed).
 func renderEncodeStats(w fieldWriter, item spindle.QueueItem) {
 	enc := item.Encoding
 	if enc == nil || (enc.EncodeDurationSeconds <= 0 && enc.AverageSpeed <= 0) {
@@ -142,15 +165,18 @@ func renderEncodeStats(w fieldWriter, item spindle.QueueItem) {
 	}
 
 	var parts []string
-	if enc.EncodeDurationSeconds > 0 {
-		dur := time.Duration(enc.EncodeDurationSeconds * float64(time.Second))
-		parts = append(parts, humanizeDurationLong(dur))
+	if dur := enc.EncodeDuration(); dur > 0 {
+		parts = append(parts, humanizeSpan(dur, time.Minute, 2))
 	}
 	if enc.AverageSpeed > 0 {
 		parts = append(parts, fmt.Sprintf("%.1fx avg", enc.AverageSpeed))
 	}
 
-	w.field("Encode", strings.Join(parts, " @ "), w.styles.Text)
+	value := strings.Join(parts, " @ ")
+	if factor := item.RealtimeFactor(); factor > 0 {
+		value += fmt.Sprintf(" · %.1fx realtime", factor)
+	}
+	w.field("Encode", value, w.styles.Text)
 }
 
 // renderValidationSummary renders a one-line validation summary.
@@ -215,27 +241,61 @@ func renderFinalPath(w fieldWriter, item spindle.QueueItem) {
 	w.field("Path", value, w.styles.Text)
 }
 
-// renderSubtitleSummary renders the subtitle source summary: a count for
-// multi-episode items, a plain source label for movies and single items.
+// renderSubtitleSummary renders the subtitle sources and languages.
 func renderSubtitleSummary(w fieldWriter, item spindle.QueueItem) {
 	episodes, _ := item.EpisodeSnapshot()
-	if len(episodes) == 0 {
-		return
-	}
+	w.field("Subs", subtitleSummary(episodes), w.styles.AccentText)
+}
 
-	count := 0
+// subtitleSummary summarizes subtitle tracks across episodes: per-source
+// counts, most common first ("4 WhisperX, 2 OpenSubtitles"), then the
+// languages ("· en/es"). A single episode shows the bare source label.
+// Episodes without a subtitle source are skipped; "" when none has one.
+func subtitleSummary(episodes []spindle.EpisodeStatus) string {
+	counts := make(map[string]int)
+	var sources, langs []string
 	for _, ep := range episodes {
-		if strings.EqualFold(strings.TrimSpace(ep.SubtitleSource), "whisperx") {
-			count++
+		src := subtitleSourceLabel(ep.SubtitleSource)
+		if src == "" {
+			continue
+		}
+		if counts[src] == 0 {
+			sources = append(sources, src)
+		}
+		counts[src]++
+		if lang := strings.ToLower(strings.TrimSpace(ep.SubtitleLanguage)); lang != "" && !slices.Contains(langs, lang) {
+			langs = append(langs, lang)
 		}
 	}
-	if count == 0 {
-		return
+	if len(sources) == 0 {
+		return ""
 	}
+	sort.SliceStable(sources, func(i, j int) bool { return counts[sources[i]] > counts[sources[j]] })
 
-	if len(episodes) == 1 {
-		w.field("Subs", "WhisperX", w.styles.AccentText)
-		return
+	parts := make([]string, len(sources))
+	for i, src := range sources {
+		parts[i] = src
+		if len(episodes) > 1 {
+			parts[i] = fmt.Sprintf("%d %s", counts[src], src)
+		}
+	}
+	summary := strings.Join(parts, ", ")
+	if len(langs) > 0 {
+		summary += " · " + strings.Join(langs, "/")
+	}
+	return summary
+}
+
+// subtitleSourceLabel maps spindle's subtitle source to a display label.
+func subtitleSourceLabel(source string) string {
+	switch s := strings.TrimSpace(source); strings.ToLower(s) {
+	case "":
+		return ""
+	case "whisperx":
+		return "WhisperX"
+	case "opensubtitles":
+		return "OpenSubtitles"
+	default:
+		return s
 	}
-	w.field("Subs", fmt.Sprintf("%d WhisperX", count), w.styles.AccentText)
 }
@@ -3,7 +3,9 @@ package ui
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/five82/flyer/internal/prefs"
 	"github.com/five82/flyer/internal/spindle"
 )
 
@@ -143,6 +145,18 @@ func TestOverviewCompletedItem_OutputResults(t *testing.T) {
 	}
 }
 
+func TestOverviewCompletedItem_RealtimeFactor(t *testing.T) {
+	got := overviewFor(t, spindle.QueueItem{
+		ID:       7,
+		Stage:    "completed",
+		Source:   &spindle.SourceTitle{TitleID: 1, DurationSeconds: 7200},
+		Encoding: &spindle.EncodingStatus{EncodeDurationSeconds: 2400, AverageSpeed: 3},
+	})
+	if !strings.Contains(got, "40m @ 3.0x avg · 3.0x realtime") {
+		t.Fatalf("overview missing realtime factor, got:\n%s", got)
+	}
+}
+
 func TestOverviewTVItem_EpisodeSummary(t *testing.T) {
 	episodes := make([]spindle.EpisodeStatus, 4)
 	for i := range episodes {
@@ -188,3 +202,278 @@ func TestWrapText(t *testing.T) {
 		})
 	}
 }
+
+func organizingOverview(t *testing.T, progress spindle.TaskProgress) string {
+	t.Helper()
+	return overviewFor(t, spindle.QueueItem{
+		ID:    1,
+		Stage: "organizing",
+		Tasks: []spindle.Task{{Type: "organizing", State: "running", Progress: progress}},
+	})
+}
+
+func TestOverviewOrganizing_PercentOnly(t *testing.T) {
+	got := organizingOverview(t, spindle.TaskProgress{Percent: 30})
+	if !strings.Contains(got, " 30%") {
+		t.Fatalf("overview missing percent, got:\n%s", got)
+	}
+	if strings.Contains(got, "MiB") || strings.Contains(got, "GiB") {
+		t.Fatalf("overview must not show byte progress without byte counts, got:\n%s", got)
+	}
+}
+
+func TestOverviewOrganizing_BytesOnlyFallsBackToBytePercent(t *testing.T) {
+	got := organizingOverview(t, spindle.TaskProgress{BytesCopied: 1 << 30, TotalBytes: 4 << 30})
+	for _, want := range []string{" 25%", "1.00 GiB / 4.00 GiB (25%)"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("overview missing %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestOverviewOrganizing_PercentAndBytes(t *testing.T) {
+	got := organizingOverview(t, spindle.TaskProgress{Percent: 50, BytesCopied: 3 << 30, TotalBytes: 4 << 30})
+	for _, want := range []string{" 50%", "3.00 GiB / 4.00 GiB (75%)"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("overview missing %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestExtrapolateProgress_EncodeRunsTowardETA(t *testing.T) {
+	snap := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	item := spindle.QueueItem{
+		ID:       1,
+		Stage:    "encoding",
+		Tasks:    []spindle.Task{{Type: "encoding", State: "running", Progress: spindle.TaskProgress{Percent: 50}}},
+		Encoding: &spindle.EncodingStatus{Percent: 50, ETASeconds: 100},
+	}
+
+	got := extrapolateProgress(item, snap, snap.Add(50*time.Second))
+	if pct := got.Tasks[0].Progress.Percent; pct != 75 {
+		t.Fatalf("percent halfway to ETA = %v, want 75", pct)
+	}
+	if eta := got.Encoding.ETASeconds; eta != 50 {
+		t.Fatalf("ETA = %v, want 50", eta)
+	}
+	if item.Tasks[0].Progress.Percent != 50 || item.Encoding.ETASeconds != 100 {
+		t.Fatalf("extrapolation must not modify the snapshot item")
+	}
+
+	got = extrapolateProgress(item, snap, snap.Add(10*time.Minute))
+	if pct := got.Tasks[0].Progress.Percent; pct != maxExtrapolatedPercent {
+		t.Fatalf("percent past ETA = %v, want capped at %d", pct, maxExtrapolatedPercent)
+	}
+}
+
+func TestExtrapolateProgress_UsesRateSinceStart(t *testing.T) {
+	snap := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	item := spindle.QueueItem{
+		ID:    1,
+		Stage: "ripping",
+		Tasks: []spindle.Task{
+			{Type: "ripping", State: "running", StartedAt: snap.Add(-100 * time.Second).Format(time.RFC3339), Progress: spindle.TaskProgress{Percent: 20}},
+			{Type: "encoding", State: "pending"},
+		},
+	}
+	got := extrapolateProgress(item, snap, snap.Add(50*time.Second))
+	if pct := got.Tasks[0].Progress.Percent; pct != 30 {
+		t.Fatalf("percent = %v, want 30 (20%% per 100s for 50s)", pct)
+	}
+	if pct := got.Tasks[1].Progress.Percent; pct != 0 {
+		t.Fatalf("pending task percent = %v, want 0", pct)
+	}
+	if same := extrapolateProgress(item, snap, snap); same.Tasks[0].Progress.Percent != 20 {
+		t.Fatalf("no elapsed time must leave progress unchanged")
+	}
+}
+
+func TestOverview_DetailRefreshExtrapolatesBetweenSnapshots(t *testing.T) {
+	snap := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	now := snap
+	m := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{DetailRefreshMillis: 500}})
+	m.now = func() time.Time { return now }
+	m.lastUpdated = snap
+	item := spindle.QueueItem{
+		ID:       1,
+		Stage:    "encoding",
+		Tasks:    []spindle.Task{{Type: "encoding", State: "running", Progress: spindle.TaskProgress{Percent: 40}}},
+		Encoding: &spindle.EncodingStatus{Percent: 40, ETASeconds: 60},
+	}
+
+	if got := stripANSI(m.renderDetailContent(item, 100)); !strings.Contains(got, " 40%") {
+		t.Fatalf("overview at snapshot time missing 40%%, got:\n%s", got)
+	}
+	now = snap.Add(30 * time.Second)
+	got := stripANSI(m.renderDetailContent(item, 100))
+	if !strings.Contains(got, " 70%") || !strings.Contains(got, "ETA 30s") {
+		t.Fatalf("overview 30s after snapshot missing 70%% / ETA 30s, got:\n%s", got)
+	}
+
+	m.prefs.DetailRefreshMillis = 0
+	if got := stripANSI(m.renderDetailContent(item, 100)); !strings.Contains(got, " 40%") {
+		t.Fatalf("disabled refresh must render snapshot progress, got:\n%s", got)
+	}
+}
+
+func TestSummarizeMetadata_TypedFields(t *testing.T) {
+	item := spindle.QueueItem{Metadata: []byte(`{"id":1234567,"media_type":"movie","season_number":1,"overview":"x","vote_count":10,"certification":"PG"}`)}
+	meta, err := item.ParseMetadata()
+	if err != nil {
+		t.Fatalf("ParseMetadata returned error: %v", err)
+	}
+	rows := summarizeMetadata(meta)
+	want := []metadataRow{{key: "certification", value: "PG"}, {key: "id", value: "1234567"}}
+	if len(rows) != len(want) {
+		t.Fatalf("rows = %v, want %v", rows, want)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("rows = %v, want %v", rows, want)
+		}
+	}
+}
+
+func TestHumanizeSpan(t *testing.T) {
+	cases := []struct {
+		d     time.Duration
+		unit  time.Duration
+		parts int
+		want  string
+	}{
+		{0, time.Second, 2, ""},
+		{-time.Minute, time.Second, 2, ""},
+		{200 * time.Millisecond, time.Second, 2, "<1s"},
+		{42 * time.Second, time.Second, 2, "42s"},
+		{42 * time.Second, time.Minute, 2, "1m"},
+		{20 * time.Second, time.Minute, 2, "<1m"},
+		{4*time.Minute + 5*time.Second, time.Second, 2, "4m 5s"},
+		{59*time.Minute + 59*time.Second + 600*time.Millisecond, time.Second, 2, "1h 0m"},
+		{80 * time.Minute, time.Minute, 2, "1h 20m"},
+		{80*time.Minute + 40*time.Second, time.Minute, 2, "1h 21m"},
+		{80*time.Minute + 40*time.Second, time.Second, 3, "1h 20m 40s"},
+		{90 * time.Minute, time.Minute, 1, "2h"},
+		{27 * time.Hour, time.Minute, 2, "1d 3h"},
+		{50*time.Hour + 40*time.Minute, time.Minute, 1, "2d"},
+		{9 * 24 * time.Hour, time.Minute, 2, "9d 0h"},
+	}
+	for _, tc := range cases {
+		if got := humanizeSpan(tc.d, tc.unit, tc.parts); got != tc.want {
+			t.Errorf("humanizeSpan(%v, %v, %d) = %q, want %q", tc.d, tc.unit, tc.parts, got, tc.want)
+		}
+	}
+}
+
+func TestHumanizeDuration(t *testing.T) {
+	cases := map[time.Duration]string{
+		-time.Second:                    "just now",
+		30 * time.Second:                "just now",
+		90 * time.Second:                "2m ago",
+		59*time.Minute + 50*time.Second: "1h ago",
+		5 * time.Hour:                   "5h ago",
+		3*24*time.Hour + time.Hour:      "3d ago",
+	}
+	for d, want := range cases {
+		if got := humanizeDuration(d); got != want {
+			t.Errorf("humanizeDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestFormatETAClock(t *testing.T) {
+	loc := time.FixedZone("test", -5*3600)
+	now := time.Date(2026, 3, 10, 14, 20, 0, 0, loc) // Tuesday
+	cases := []struct {
+		eta  time.Duration
+		want string
+	}{
+		{82 * time.Minute, "done ~15:42"},
+		{29 * time.Second, "done ~14:20"},
+		{31 * time.Second, "done ~14:21"},
+		{9*time.Hour + 39*time.Minute + 20*time.Second, "done ~23:59"},
+		{9*time.Hour + 39*time.Minute + 40*time.Second, "done ~Wed 00:00"},
+		{9*time.Hour + 40*time.Minute, "done ~Wed 00:00"},
+		{11 * time.Hour, "done ~Wed 01:20"},
+		{3 * 24 * time.Hour, "done ~Fri 14:20"},
+		{8 * 24 * time.Hour, "done ~Mar 18 14:20"},
+	}
+	for _, tc := range cases {
+		if got := formatETAClock(now, tc.eta); got != tc.want {
+			t.Errorf("formatETAClock(%v) = %q, want %q", tc.eta, got, tc.want)
+		}
+	}
+}
+
+func TestFormatETA_ClockTimePref(t *testing.T) {
+	now := time.Date(2026, 3, 10, 23, 30, 0, 0, time.Local)
+	m := New(Options{ThemeName: "slate"})
+	m.now = func() time.Time { return now }
+	if got := m.formatETA(80 * time.Minute); got != "ETA 1h 20m" {
+		t.Fatalf("formatETA() = %q, want relative only", got)
+	}
+	m = New(Options{ThemeName: "slate", Prefs: prefs.Prefs{ETAClockTime: true}})
+	m.now = func() time.Time { return now }
+	if got := m.formatETA(80 * time.Minute); got != "ETA 1h 20m (done ~Wed 00:50)" {
+		t.Fatalf("formatETA() = %q, want clock time on the next day", got)
+	}
+}
+
+func TestSubtitleSummary(t *testing.T) {
+	tests := []struct {
+		name     string
+		episodes []spindle.EpisodeStatus
+		want     string
+	}{
+		{"none", nil, ""},
+		{"no sources", []spindle.EpisodeStatus{{Key: "s01e01"}}, ""},
+		{"movie", []spindle.EpisodeStatus{{SubtitleSource: "whisperx", SubtitleLanguage: "EN"}}, "WhisperX · en"},
+		{"tv mixed", []spindle.EpisodeStatus{
+			{SubtitleSource: "opensubtitles", SubtitleLanguage: "en"},
+			{SubtitleSource: "whisperx", SubtitleLanguage: "en"},
+			{SubtitleSource: "WhisperX", SubtitleLanguage: "es"},
+			{},
+			{SubtitleSource: "whisperx"},
+		}, "3 WhisperX, 1 OpenSubtitles · en/es"},
+	}
+	for _, tt := range tests {
+		if got := subtitleSummary(tt.episodes); got != tt.want {
+			t.Errorf("%s: subtitleSummary = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAudioTrackSummary(t *testing.T) {
+	tests := []struct {
+		item spindle.QueueItem
+		want string
+	}{
+		{spindle.QueueItem{PrimaryAudioDescription: "TrueHD 7.1"}, "TrueHD 7.1"},
+		{spindle.QueueItem{PrimaryAudioDescription: "TrueHD 7.1", Encoding: &spindle.EncodingStatus{AudioCodec: "opus"}}, "TrueHD 7.1 -> opus"},
+		{spindle.QueueItem{Encoding: &spindle.EncodingStatus{AudioCodec: "opus"}}, "opus"},
+		{spindle.QueueItem{}, ""},
+	}
+	for _, tt := range tests {
+		if got := audioTrackSummary(tt.item); got != tt.want {
+			t.Errorf("audioTrackSummary(%+v) = %q, want %q", tt.item, got, tt.want)
+		}
+	}
+}
+
+func TestOverview_TracksSection(t *testing.T) {
+	got := overviewFor(t, spindle.QueueItem{
+		ID:                      2,
+		Stage:                   "completed",
+		PrimaryAudioDescription: "DTS-HD MA 5.1",
+		Encoding:                &spindle.EncodingStatus{Resolution: "3840x2160", DynamicRange: "hdr10", AudioCodec: "opus"},
+		Episodes: []spindle.EpisodeStatus{
+			{Key: "s01e01", SubtitleSource: "whisperx", SubtitleLanguage: "en"},
+			{Key: "s01e02", SubtitleSource: "opensubtitles", SubtitleLanguage: "en"},
+		},
+	})
+	sectionOrder(t, got, "Pipeline", "Tracks", "3840x2160 HDR10", "DTS-HD MA 5.1 -> opus", "1 WhisperX, 1 OpenSubtitles · en")
+
+	// Without encoding or episode data the section still renders the
+	// source audio alone, and nothing panics.
+	got = overviewFor(t, spindle.QueueItem{ID: 3, Stage: "pending", PrimaryAudioDescription: "AC3 2.0"})
+	sectionOrder(t, got, "Tracks", "AC3 2.0")
+}
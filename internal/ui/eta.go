@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// ETA smoothing constants.
+const (
+	// etaSmoothingAlpha weights each new server ETA against the running
+	// estimate; lower values change the displayed ETA more gradually.
+	etaSmoothingAlpha = 0.3
+	// etaJumpFactor is how far a new ETA may move from the running estimate
+	// (in either direction) before it is taken as a new phase and adopted
+	// outright.
+	etaJumpFactor = 2.0
+)
+
+// etaSmoother keeps an exponential moving average of each item's encode
+// ETA so the displayed figure doesn't bounce with every poll. The zero
+// value is ready to use.
+type etaSmoother struct {
+	entries map[int64]etaEntry
+}
+
+// etaEntry is one item's smoothed ETA as of a snapshot.
+type etaEntry struct {
+	stage string
+	eta   time.Duration
+	at    time.Time
+}
+
+// smoothETA folds a raw ETA observed at now into the previous entry. The
+// previous estimate is first aged by the time since it was taken, so a
+// steadily counting-down ETA passes through unchanged. A missing or
+// expired estimate, a stage change, or a jump beyond etaJumpFactor resets
+// to the raw value.
+func smoothETA(prev etaEntry, ok bool, stage string, raw time.Duration, now time.Time) etaEntry {
+	next := etaEntry{stage: stage, eta: raw, at: now}
+	if !ok || prev.stage != stage {
+		return next
+	}
+	aged := prev.eta - now.Sub(prev.at)
+	if aged <= 0 || float64(raw) > float64(aged)*etaJumpFactor || float64(raw)*etaJumpFactor < float64(aged) {
+		return next
+	}
+	next.eta = aged + time.Duration(etaSmoothingAlpha*float64(raw-aged))
+	return next
+}
+
+// Observe updates the smoother from a snapshot's queue, dropping items that
+// no longer report an encode ETA.
+func (s *etaSmoother) Observe(queue []spindle.QueueItem, now time.Time) {
+	next := make(map[int64]etaEntry, len(queue))
+	for _, item := range queue {
+		raw := item.Encoding.ETADuration()
+		if raw <= 0 {
+			continue
+		}
+		prev, ok := s.entries[item.ID]
+		next[item.ID] = smoothETA(prev, ok, item.Stage, raw, now)
+	}
+	s.entries = next
+}
+
+// Apply returns item with its encode ETA replaced by the smoothed value,
+// copying Encoding so the snapshot is left untouched. Items the smoother
+// hasn't seen are returned as-is.
+func (s etaSmoother) Apply(item spindle.QueueItem) spindle.QueueItem {
+	entry, ok := s.entries[item.ID]
+	if !ok || item.Encoding == nil {
+		return item
+	}
+	smoothed := *item.Encoding
+	smoothed.ETASeconds = entry.eta.Seconds()
+	item.Encoding = &smoothed
+	return item
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
+)
+
+func TestSmoothETA_MovingAverage(t *testing.T) {
+	t0 := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	prev := etaEntry{stage: "encoding", eta: 10 * time.Minute, at: t0}
+
+	// Aged by 1m the estimate is 9m; a raw 11m moves it 30% of the way.
+	got := smoothETA(prev, true, "encoding", 11*time.Minute, t0.Add(time.Minute))
+	if want := 9*time.Minute + 36*time.Second; got.eta != want {
+		t.Fatalf("eta = %v, want %v", got.eta, want)
+	}
+
+	// A raw ETA counting down in step with the clock passes through.
+	got = smoothETA(prev, true, "encoding", 8*time.Minute, t0.Add(2*time.Minute))
+	if got.eta != 8*time.Minute {
+		t.Fatalf("eta = %v, want the steady countdown 8m", got.eta)
+	}
+}
+
+func TestSmoothETA_Resets(t *testing.T) {
+	t0 := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	prev := etaEntry{stage: "encoding", eta: 10 * time.Minute, at: t0}
+	now := t0.Add(time.Minute)
+
+	tests := []struct {
+		name  string
+		prev  etaEntry
+		ok    bool
+		stage string
+		raw   time.Duration
+	}{
+		{"first observation", etaEntry{}, false, "encoding", 7 * time.Minute},
+		{"stage change", prev, true, "subtitling", 7 * time.Minute},
+		{"jump up", prev, true, "encoding", 30 * time.Minute},
+		{"jump down", prev, true, "encoding", 2 * time.Minute},
+		{"expired estimate", etaEntry{stage: "encoding", eta: 30 * time.Second, at: t0}, true, "encoding", 7 * time.Minute},
+	}
+	for _, tt := range tests {
+		got := smoothETA(tt.prev, tt.ok, tt.stage, tt.raw, now)
+		if got.eta != tt.raw || got.stage != tt.stage || !got.at.Equal(now) {
+			t.Errorf("%s: got %+v, want a reset to %v", tt.name, got, tt.raw)
+		}
+	}
+}
+
+func TestETASmoother_ObserveAndApply(t *testing.T) {
+	t0 := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	item := spindle.QueueItem{ID: 3, Stage: "encoding", Encoding: &spindle.EncodingStatus{ETASeconds: 600}}
+	var s etaSmoother
+
+	s.Observe([]spindle.QueueItem{item}, t0)
+	item.Encoding = &spindle.EncodingStatus{ETASeconds: 660}
+	s.Observe([]spindle.QueueItem{item}, t0.Add(time.Minute))
+
+	got := s.Apply(item)
+	if got.Encoding.ETASeconds != 576 {
+		t.Fatalf("smoothed ETA = %vs, want 576s", got.Encoding.ETASeconds)
+	}
+	if item.Encoding.ETASeconds != 660 {
+		t.Fatalf("Apply mutated the snapshot item: %vs", item.Encoding.ETASeconds)
+	}
+
+	// Items that stop reporting an ETA are dropped.
+	item.Encoding = nil
+	s.Observe([]spindle.QueueItem{item}, t0.Add(2*time.Minute))
+	if _, ok := s.entries[3]; ok {
+		t.Fatal("entry kept after the ETA disappeared")
+	}
+}
+
+func TestApplySnapshot_FetchMarkDoesNotReobserveETAs(t *testing.T) {
+	var store state.Store
+	store.Update(&spindle.StatusResponse{}, []spindle.QueueItem{
+		{ID: 1, Stage: "encoding", Encoding: &spindle.EncodingStatus{ETASeconds: 600}},
+	}, nil)
+
+	t0 := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	now := t0
+	m := New(Options{ThemeName: "slate", PrefsPath: t.TempDir() + "/prefs.toml"})
+	m.now = func() time.Time { return now }
+	m.applySnapshot(store.Snapshot())
+
+	// The poller's fetch mark arrives as its own snapshot; the data is
+	// unchanged, so the smoother must not age the estimate again.
+	now = t0.Add(time.Minute)
+	store.BeginFetch()
+	m.applySnapshot(store.Snapshot())
+	if !m.snapshot.Fetching || !m.spinnerActive() {
+		t.Fatalf("Fetching = %v, spinner active = %v; want the fetch shown", m.snapshot.Fetching, m.spinnerActive())
+	}
+	if got := m.etas.entries[1].at; !got.Equal(t0) {
+		t.Fatalf("ETA observed again at %v on the fetch mark, want only at %v", got, t0)
+	}
+}
@@ -1,12 +1,16 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
 	"strings"
 	"time"
 
 	"charm.land/lipgloss/v2"
 
+	"github.com/five82/flyer/internal/prefs"
 	"github.com/five82/flyer/internal/spindle"
 )
 
@@ -25,6 +29,20 @@ type headerPart struct {
 	rank int
 }
 
+// compact reports whether the header and NOW band use their compact
+// layouts: below compactWidthThreshold unless the compact_mode pref forces
+// it on or off.
+func (m Model) compact() bool {
+	switch m.prefs.CompactMode {
+	case prefs.CompactAlways:
+		return true
+	case prefs.CompactNever:
+		return false
+	default:
+		return m.width < compactWidthThreshold
+	}
+}
+
 // renderHeader renders the top status band (Surface-filled).
 func (m Model) renderHeader() string {
 	styles := m.theme.BandStyles()
@@ -33,7 +51,7 @@ func (m Model) renderHeader() string {
 		return m.renderConnectingHeader(styles)
 	}
 
-	compact := m.width < compactWidthThreshold
+	compact := m.compact()
 	failed, review := m.countProblemCounts()
 
 	var parts []headerPart
@@ -57,11 +75,25 @@ func (m Model) renderHeader() string {
 		parts = append(parts, headerPart{p, 2})
 	}
 
-	// Timestamp
-	if timeStr := m.formatTimestamp(); timeStr != "" {
+	// Timestamp, led by a spinner while a poll is in flight.
+	timeStr := m.formatTimestamp()
+	if m.snapshot.Fetching {
+		timeStr = strings.TrimSpace(m.spinnerGlyph() + " " + timeStr)
+	}
+	if timeStr != "" {
 		parts = append(parts, headerPart{styles.MutedText.Render(timeStr), 4})
 	}
 
+	// Last completion: a throughput hint, first to go when space is short.
+	if last := m.formatLastCompleted(compact, styles); last != "" {
+		parts = append(parts, headerPart{last, 5})
+	}
+
+	// Update hint: subtle and the first to go.
+	if m.newVersion != "" {
+		parts = append(parts, headerPart{styles.FaintText.Render(m.newVersion + " available"), 6})
+	}
+
 	// Health warnings
 	if healthWarning := m.formatHealthWarning(compact, styles); healthWarning != "" {
 		parts = append(parts, headerPart{healthWarning, 2})
@@ -110,6 +142,16 @@ func headerPartsWidth(parts []headerPart) int {
 	return w
 }
 
+// retryLabel reports when the poller retries after a failure, e.g.
+// "Retrying in 8s...".
+func (m Model) retryLabel() string {
+	remaining := m.snapshot.RetryAt.Sub(m.clock())
+	if m.snapshot.RetryAt.IsZero() || remaining <= 0 {
+		return "Retrying..."
+	}
+	return fmt.Sprintf("Retrying in %ds...", int(math.Ceil(remaining.Seconds())))
+}
+
 // renderConnectingHeader shows the connecting/error state as a band.
 func (m Model) renderConnectingHeader(styles Styles) string {
 	sep := styles.Band.Render("  ")
@@ -124,7 +166,7 @@ func (m Model) renderConnectingHeader(styles Styles) string {
 		parts := []string{
 			styles.Logo.Render("flyer"),
 			styles.DangerText.Bold(true).Render("SPINDLE " + errorMsg),
-			styles.WarningText.Bold(true).Render(m.spinnerGlyph() + " Retrying..."),
+			styles.WarningText.Bold(true).Render(m.spinnerGlyph() + " " + m.retryLabel()),
 			styles.MutedText.Render(last),
 		}
 
@@ -145,6 +187,37 @@ func (m Model) renderConnectingHeader(styles Styles) string {
 		m.width, styles.Band)
 }
 
+// latestCompleted returns the completed item with the newest UpdatedAt;
+// ties go to the higher ID. Items without a parseable UpdatedAt are
+// skipped. ok is false when nothing has completed.
+func latestCompleted(queue []spindle.QueueItem) (item spindle.QueueItem, finished time.Time, ok bool) {
+	for _, candidate := range queue {
+		if !strings.EqualFold(candidate.Stage, "completed") {
+			continue
+		}
+		updated := candidate.ParsedUpdatedAt()
+		if updated.IsZero() {
+			continue
+		}
+		if !ok || updated.After(finished) || (updated.Equal(finished) && candidate.ID > item.ID) {
+			item, finished, ok = candidate, updated, true
+		}
+	}
+	return item, finished, ok
+}
+
+// formatLastCompleted renders the most recent completion, e.g.
+// "Last: Alien 12m ago".
+func (m Model) formatLastCompleted(compact bool, styles Styles) string {
+	item, finished, ok := latestCompleted(m.snapshot.Queue)
+	if !ok {
+		return ""
+	}
+	title := truncate(composeTitle(item), maxLen(compact, 30, 15))
+	return styles.MutedText.Render("Last: ") + styles.Text.Render(title) +
+		styles.FaintText.Render(" "+humanizeDuration(m.clock().Sub(finished)))
+}
+
 // countProcessingItems returns the number of items with running tasks.
 func (m Model) countProcessingItems() int {
 	count := 0
@@ -195,9 +268,19 @@ func (m Model) buildErrorParts(compact bool, styles Styles) []string {
 	}
 
 	if m.snapshot.LastError != nil {
+		label := apiErrorLabel(m.snapshot.LastError)
+		if label == "" {
+			label = "ERROR"
+		}
 		errText := truncate(fmt.Sprintf("%v", m.snapshot.LastError), maxLen(compact, 80, 40))
 		parts = append(parts,
-			styles.DangerText.Bold(true).Render("ERROR")+styles.DangerText.Render(" "+errText))
+			styles.DangerText.Bold(true).Render(label)+styles.DangerText.Render(" "+errText))
+	}
+
+	if m.snapshot.ConfigError != nil {
+		errText := truncate("reload failed: "+m.snapshot.ConfigError.Error(), maxLen(compact, 80, 40))
+		parts = append(parts,
+			styles.WarningText.Bold(true).Render("CONFIG")+styles.WarningText.Render(" "+errText))
 	}
 
 	if m.errorMsg != "" {
@@ -264,20 +347,52 @@ func (m Model) formatHealthWarning(compact bool, styles Styles) string {
 		return ""
 	}
 
-	detail := unhealthy[0]
-	if len(unhealthy) > 1 {
-		detail = fmt.Sprintf("%s +%d more", detail, len(unhealthy)-1)
+	var detail string
+	switch rotate := m.prefs.HealthRotateSeconds; {
+	case len(unhealthy) == 1:
+		detail = unhealthy[0]
+	case rotate > 0:
+		// Step through the unhealthy set on the model clock so every
+		// dependency gets airtime.
+		idx := int(m.clock().Unix()/int64(rotate)) % len(unhealthy)
+		detail = fmt.Sprintf("%s (%d/%d)", unhealthy[idx], idx+1, len(unhealthy))
+	default:
+		detail = fmt.Sprintf("%s +%d more", unhealthy[0], len(unhealthy)-1)
 	}
-	detail = truncate(detail, maxLen(compact, 80, 40))
+	width := 80
+	if m.prefs.HealthDetailWidth > 0 {
+		width = m.prefs.HealthDetailWidth
+	}
+	detail = truncate(detail, maxLen(compact, width, max(width/2, 1)))
 
 	return styles.DangerText.Bold(true).Render("HEALTH") + styles.DangerText.Render(" "+detail)
 }
 
+// apiErrorLabel names an API status failure, empty for other errors.
+func apiErrorLabel(err error) string {
+	var apiErr *spindle.APIError
+	switch {
+	case errors.Is(err, spindle.ErrUnauthorized):
+		return "AUTH FAILED"
+	case !errors.As(err, &apiErr):
+		return ""
+	case apiErr.StatusCode == http.StatusServiceUnavailable:
+		return "DAEMON BUSY"
+	case apiErr.StatusCode >= 500:
+		return "SERVER ERROR"
+	default:
+		return ""
+	}
+}
+
 // classifyConnectionError returns a short description of the connection error.
 func classifyConnectionError(err error) string {
 	if err == nil {
 		return ""
 	}
+	if label := apiErrorLabel(err); label != "" {
+		return label
+	}
 	msg := err.Error()
 	switch {
 	case strings.Contains(msg, "connection refused"):
@@ -337,23 +452,47 @@ func (m Model) renderCommandBar() string {
 			{"/", "Search", 2},
 			{"n/N", "Next/Prev", 3},
 			{"f", "Filters", 3},
+			{"e", "Level", 3},
+			{"]/[", "Warn/Err", 3},
+			{"m", "All items", 3},
+			{"w", "Wrap", 4},
+			{"X", "Export", 3},
 			{"Esc", "Queue", 1},
 		}
+		if m.logState.mode == logSourceAll {
+			commands = append(commands[:len(commands)-1], cmd{"o", "Focus", 3}, commands[len(commands)-1])
+		}
 
-	case m.currentView == ViewProblems:
+	case m.currentView == ViewProblems, m.currentView == ViewCompleted, m.currentView == ViewReasons:
 		commands = []cmd{
 			{"j/k", "Navigate", 3},
 			{"Enter", "Inspect", 2},
 			{"Esc", "Queue", 1},
 		}
+		switch m.currentView {
+		case ViewCompleted:
+			commands = append(commands[:2], cmd{"o", "Open", 3}, commands[2])
+		case ViewProblems:
+			commands = append(commands[:2], cmd{"y", "Copy report", 3}, commands[2])
+		case ViewReasons:
+			commands[1] = cmd{"Enter", "Filter queue", 2}
+		}
+
+	case m.currentView == ViewStats, m.currentView == ViewHealth, m.currentView == ViewWatch:
+		commands = []cmd{
+			{"Esc", "Queue", 1},
+		}
 
 	default: // ViewQueue
 		commands = []cmd{
 			{"/", "Filter", 2},
 			{"f", m.filterLabel(), 2}, // Shows current filter state
+			{"s", "Sort", 3},
+			{"v", "Lanes", 3},
 			{"j/k", "Navigate", 3},
 			{"Enter", "Inspect", 2},
 			{"i", "Item logs", 3},
+			{"w", "Watch", 4},
 			{"l", "Daemon", 3},
 			{"p", "Problems", 3},
 			{"r", "Refresh", 3},
@@ -371,8 +510,7 @@ func (m Model) renderCommandBar() string {
 	}
 
 	// Show active log search pattern
-	logsActive := (m.currentView == ViewLogs && !m.inspecting) || (m.inspecting && m.inspectorTab == tabLogs)
-	if logsActive && m.logState.searchQuery != "" {
+	if m.logsVisible() && m.logState.searchQuery != "" {
 		pattern := truncate(m.logState.searchQuery, 18)
 		parts = append(parts, headerPart{styles.AccentText.Render("/" + pattern), 2})
 	}
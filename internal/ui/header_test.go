@@ -1,9 +1,16 @@
 package ui
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 
+	"charm.land/lipgloss/v2"
+
+	"github.com/five82/flyer/internal/prefs"
 	"github.com/five82/flyer/internal/spindle"
 	"github.com/five82/flyer/internal/state"
 )
@@ -34,3 +41,202 @@ func TestBuildErrorPartsShowsWorkflowLastError(t *testing.T) {
 		}
 	}
 }
+
+func TestConfigReloadShowsErrorAndEndpoint(t *testing.T) {
+	m := New(Options{ThemeName: "slate", APIEndpoint: "http://127.0.0.1:7487"})
+	m.applySnapshot(state.Snapshot{
+		Version:     1,
+		Endpoint:    "http://nas:7487",
+		ConfigError: errors.New("parse config: toml: expected character ="),
+	})
+	if m.apiEndpoint != "http://nas:7487" {
+		t.Fatalf("apiEndpoint = %q, want the reloaded endpoint", m.apiEndpoint)
+	}
+	parts := m.buildErrorParts(false, m.theme.Styles())
+	if len(parts) != 1 || !strings.Contains(stripANSI(parts[0]), "CONFIG reload failed: parse config") {
+		t.Fatalf("error parts = %q, want the config reload error", parts)
+	}
+}
+
+func TestClassifyConnectionError_Unauthorized(t *testing.T) {
+	err := fmt.Errorf("api /api/status returned status 401: %w", spindle.ErrUnauthorized)
+	if got := classifyConnectionError(err); got != "AUTH FAILED" {
+		t.Fatalf("classifyConnectionError() = %q, want AUTH FAILED", got)
+	}
+	if got := classifyConnectionError(errors.New("dial tcp: connection refused")); got != "OFFLINE" {
+		t.Fatalf("classifyConnectionError() = %q, want OFFLINE", got)
+	}
+}
+
+func TestClassifyConnectionError_APIStatus(t *testing.T) {
+	cases := map[int]string{
+		http.StatusServiceUnavailable:  "DAEMON BUSY",
+		http.StatusInternalServerError: "SERVER ERROR",
+		http.StatusBadRequest:          "ERROR",
+	}
+	for code, want := range cases {
+		err := fmt.Errorf("fetch status: %w", &spindle.APIError{Endpoint: "/api/status", StatusCode: code})
+		if got := classifyConnectionError(err); got != want {
+			t.Errorf("classifyConnectionError(%d) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestBuildErrorPartsLabelsAuthFailure(t *testing.T) {
+	theme := GetTheme("Nightfox")
+	model := Model{
+		theme: theme,
+		snapshot: state.Snapshot{
+			LastError: fmt.Errorf("api /api/queue returned status 401: %w", spindle.ErrUnauthorized),
+		},
+	}
+
+	parts := model.buildErrorParts(false, theme.Styles())
+	if len(parts) != 1 || !strings.Contains(stripANSI(parts[0]), "AUTH FAILED") {
+		t.Fatalf("error parts = %q, want AUTH FAILED label", parts)
+	}
+}
+
+func healthModel(p prefs.Prefs, now time.Time) Model {
+	m := New(Options{ThemeName: "slate", Prefs: p})
+	m.now = func() time.Time { return now }
+	m.snapshot.Status.Dependencies = []spindle.DependencyStatus{
+		{Name: "makemkv", Available: false, Detail: "license expired"},
+		{Name: "ffmpeg", Available: true},
+		{Name: "drapto", Available: false},
+		{Name: "mkvmerge", Available: false, Detail: "not found"},
+	}
+	return m
+}
+
+func TestFormatHealthWarning_DefaultShowsFirstPlusMore(t *testing.T) {
+	m := healthModel(prefs.Prefs{}, time.Unix(0, 0))
+	got := stripANSI(m.formatHealthWarning(false, m.theme.Styles()))
+	if got != "HEALTH makemkv – license expired +2 more" {
+		t.Fatalf("formatHealthWarning() = %q", got)
+	}
+}
+
+func TestFormatHealthWarning_RotatesThroughUnhealthy(t *testing.T) {
+	want := []string{
+		"HEALTH makemkv – license expired (1/3)",
+		"HEALTH drapto (2/3)",
+		"HEALTH mkvmerge – not found (3/3)",
+		"HEALTH makemkv – license expired (1/3)",
+	}
+	for step, w := range want {
+		m := healthModel(prefs.Prefs{HealthRotateSeconds: 5}, time.Unix(int64(step*5), 0))
+		if got := stripANSI(m.formatHealthWarning(false, m.theme.Styles())); got != w {
+			t.Fatalf("step %d: formatHealthWarning() = %q, want %q", step, got, w)
+		}
+	}
+}
+
+func TestFormatHealthWarning_ConfigurableWidth(t *testing.T) {
+	m := healthModel(prefs.Prefs{HealthDetailWidth: 12}, time.Unix(0, 0))
+	styles := m.theme.Styles()
+
+	full := strings.TrimPrefix(stripANSI(m.formatHealthWarning(false, styles)), "HEALTH ")
+	if w := lipgloss.Width(full); w != 12 {
+		t.Fatalf("detail width = %d (%q), want 12", w, full)
+	}
+	compact := strings.TrimPrefix(stripANSI(m.formatHealthWarning(true, styles)), "HEALTH ")
+	if w := lipgloss.Width(compact); w != 6 {
+		t.Fatalf("compact detail width = %d (%q), want 6", w, compact)
+	}
+}
+
+func TestLatestCompleted(t *testing.T) {
+	at := func(minute int) string {
+		return time.Date(2026, 1, 2, 10, minute, 0, 0, time.UTC).Format(time.RFC3339)
+	}
+
+	if _, _, ok := latestCompleted(nil); ok {
+		t.Fatalf("empty queue should report no completion")
+	}
+	pending := []spindle.QueueItem{
+		{ID: 1, Stage: "encoding", UpdatedAt: at(30)},
+		{ID: 2, Stage: "failed", UpdatedAt: at(40)},
+		{ID: 3, Stage: "completed"}, // no timestamp
+	}
+	if _, _, ok := latestCompleted(pending); ok {
+		t.Fatalf("queue without timed completions should report none")
+	}
+
+	queue := append(pending,
+		spindle.QueueItem{ID: 4, Stage: "completed", UpdatedAt: at(5)},
+		spindle.QueueItem{ID: 6, Stage: "Completed", UpdatedAt: at(20)},
+		spindle.QueueItem{ID: 5, Stage: "completed", UpdatedAt: at(20)},
+	)
+	item, finished, ok := latestCompleted(queue)
+	if !ok || item.ID != 6 || finished.Minute() != 20 {
+		t.Fatalf("latestCompleted = #%d at %v (ok=%v), want #6 at :20 (tie to higher ID)", item.ID, finished, ok)
+	}
+}
+
+func TestFormatLastCompleted(t *testing.T) {
+	done := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	m := New(Options{ThemeName: "slate"})
+	m.now = func() time.Time { return done.Add(12 * time.Minute) }
+	if got := m.formatLastCompleted(false, m.theme.Styles()); got != "" {
+		t.Fatalf("formatLastCompleted() with no completions = %q, want empty", got)
+	}
+	m.snapshot.Queue = []spindle.QueueItem{{ID: 1, Stage: "completed", DiscTitle: "Alien", UpdatedAt: done.Format(time.RFC3339)}}
+	if got := stripANSI(m.formatLastCompleted(false, m.theme.Styles())); got != "Last: Alien 12m ago" {
+		t.Fatalf("formatLastCompleted() = %q", got)
+	}
+}
+
+func TestRenderHeader_UpdateHint(t *testing.T) {
+	m := New(Options{ThemeName: "slate", CheckUpdate: func() string { return "v1.4.0" }})
+	m.width = 160
+	m.snapshot.HasStatus = true
+	if got := stripANSI(m.renderHeader()); strings.Contains(got, "available") {
+		t.Fatalf("header shows an update before the check ran: %q", got)
+	}
+	updated, _ := m.Update(updateAvailableMsg("v1.4.0"))
+	m = updated.(Model)
+	if got := stripANSI(m.renderHeader()); !strings.Contains(got, "v1.4.0 available") {
+		t.Fatalf("header = %q, want update hint", got)
+	}
+}
+
+func TestCompact_PrefOverridesWidth(t *testing.T) {
+	tests := []struct {
+		mode  string
+		width int
+		want  bool
+	}{
+		{prefs.CompactAuto, 80, true},
+		{prefs.CompactAuto, 120, false},
+		{"", 80, true},
+		{prefs.CompactAlways, 200, true},
+		{prefs.CompactNever, 60, false},
+	}
+	for _, tt := range tests {
+		m := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{CompactMode: tt.mode}})
+		m.width = tt.width
+		if got := m.compact(); got != tt.want {
+			t.Errorf("compact() with mode %q at width %d = %v, want %v", tt.mode, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestRenderConnectingHeader_ShowsRetryCountdown(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	m := New(Options{ThemeName: "slate"})
+	m.now = func() time.Time { return now }
+	m.width = 120
+	m.snapshot.LastError = errors.New("connection refused")
+	m.snapshot.ConsecutiveFailures = 3
+	m.snapshot.RetryAt = now.Add(7500 * time.Millisecond)
+
+	if got := stripANSI(m.renderHeader()); !strings.Contains(got, "Retrying in 8s...") {
+		t.Fatalf("header = %q, want retry countdown", got)
+	}
+
+	m.snapshot.RetryAt = now.Add(-time.Second)
+	if got := stripANSI(m.renderHeader()); !strings.Contains(got, "Retrying...") {
+		t.Fatalf("header = %q, want plain retrying once due", got)
+	}
+}
@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+const (
+	healthRefreshInterval = 5 * time.Second
+	healthFetchTimeout    = 5 * time.Second
+)
+
+// healthState holds the health view's own dependency fetches. Until one
+// succeeds the view shows the dependencies from the polled status.
+type healthState struct {
+	deps        []spindle.DependencyStatus
+	live        bool // deps came from /api/dependencies
+	unsupported bool // the daemon has no /api/dependencies
+	lastRefresh time.Time
+}
+
+// dependenciesMsg carries the result of a /api/dependencies fetch.
+type dependenciesMsg struct {
+	deps []spindle.DependencyStatus
+	err  error
+}
+
+// refreshDependencies fetches the dependency list for the health view.
+// It returns nil when the daemon lacks the endpoint, is offline, or was
+// asked recently.
+func (m *Model) refreshDependencies() tea.Cmd {
+	if m.client == nil || m.healthState.unsupported || m.snapshot.IsOffline() {
+		return nil
+	}
+	if m.clock().Sub(m.healthState.lastRefresh) < healthRefreshInterval {
+		return nil
+	}
+	m.healthState.lastRefresh = m.clock()
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), healthFetchTimeout)
+		defer cancel()
+		deps, err := m.client.FetchDependencies(ctx)
+		return dependenciesMsg{deps: deps, err: err}
+	}
+}
+
+// handleDependencies stores a fetched dependency list. A failed fetch
+// falls back to the status dependencies; a daemon without the endpoint is
+// not asked again.
+func (m *Model) handleDependencies(msg dependenciesMsg) {
+	if msg.err != nil {
+		m.healthState.deps, m.healthState.live = nil, false
+		m.healthState.unsupported = errors.Is(msg.err, spindle.ErrDependenciesUnsupported)
+		return
+	}
+	m.healthState.deps, m.healthState.live = msg.deps, true
+}
+
+// healthDependencies returns the dependencies the health view shows.
+func (m Model) healthDependencies() []spindle.DependencyStatus {
+	if m.healthState.live {
+		return m.healthState.deps
+	}
+	return m.snapshot.Status.Dependencies
+}
+
+// dependencySeverity ranks a dependency for the health view: missing
+// required tools first, then missing optional ones, then available ones.
+func dependencySeverity(dep spindle.DependencyStatus) int {
+	switch {
+	case !dep.Available && !dep.Optional:
+		return 0
+	case !dep.Available:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// sortDependencies returns deps ordered by severity, then name.
+func sortDependencies(deps []spindle.DependencyStatus) []spindle.DependencyStatus {
+	sorted := append([]spindle.DependencyStatus(nil), deps...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		si, sj := dependencySeverity(sorted[i]), dependencySeverity(sorted[j])
+		if si != sj {
+			return si < sj
+		}
+		return strings.ToLower(sorted[i].Name) < strings.ToLower(sorted[j].Name)
+	})
+	return sorted
+}
+
+// renderHealth renders the daemon's dependency health as a Level 1 panel:
+// one row per dependency, worst first.
+func (m Model) renderHealth() string {
+	styles := m.theme.Styles()
+	inner := panelInnerWidth(m.width)
+	deps := sortDependencies(m.healthDependencies())
+
+	var lines []string
+	available := 0
+	nameWidth := 4
+	for _, dep := range deps {
+		nameWidth = max(nameWidth, len(dep.Name))
+		if dep.Available {
+			available++
+		}
+	}
+	nameWidth = min(nameWidth, 24)
+
+	if len(deps) == 0 {
+		lines = append(lines, styles.MutedText.Render("Spindle reported no dependencies"))
+	}
+	for _, dep := range deps {
+		marker, style := "✓", styles.SuccessText
+		switch dependencySeverity(dep) {
+		case 0:
+			marker, style = "✗", styles.DangerText
+		case 1:
+			marker, style = "!", styles.WarningText
+		}
+		kind := "required"
+		if dep.Optional {
+			kind = "optional"
+		}
+		name := truncate(dep.Name, nameWidth)
+		name += strings.Repeat(" ", nameWidth-len(name))
+		line := style.Render(marker) + " " + styles.Text.Render(name) + "  " + styles.FaintText.Render(fmt.Sprintf("%-8s", kind))
+		if detail := strings.TrimSpace(dep.Detail); detail != "" {
+			line += "  " + styles.MutedText.Render(truncate(detail, max(inner-(nameWidth+14), 10)))
+		}
+		lines = append(lines, line)
+	}
+	for len(lines) < max(m.height-4, 1) {
+		lines = append(lines, "")
+	}
+
+	title := fmt.Sprintf("Health (%d/%d available)", available, len(deps))
+	return renderPanel(title, strings.Join(lines, "\n"), "", m.width, styles)
+}
+
+// handleHealthKey processes keyboard input for the health view.
+func (m Model) handleHealthKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, m.keys.Escape) {
+		m.currentView = ViewQueue
+	}
+	return m, nil
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/spindle/spindletest"
+)
+
+func TestSortDependencies_SeverityThenName(t *testing.T) {
+	deps := []spindle.DependencyStatus{
+		{Name: "mkvmerge", Available: true},
+		{Name: "whisperx", Optional: true},
+		{Name: "makemkvcon"},
+		{Name: "ffprobe", Available: true, Optional: true},
+		{Name: "Drapto"},
+		{Name: "jellyfin", Optional: true},
+	}
+	var got []string
+	for _, dep := range sortDependencies(deps) {
+		got = append(got, dep.Name)
+	}
+	want := []string{"Drapto", "makemkvcon", "jellyfin", "whisperx", "ffprobe", "mkvmerge"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("sortDependencies() = %v, want %v", got, want)
+	}
+	if deps[0].Name != "mkvmerge" {
+		t.Fatalf("sortDependencies() reordered its input")
+	}
+}
+
+func TestRenderHealth_ListsDependencies(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.width, m.height = 100, 20
+	m.snapshot.Status.Dependencies = []spindle.DependencyStatus{
+		{Name: "mkvmerge", Available: true},
+		{Name: "makemkvcon", Detail: "not found in PATH"},
+	}
+	out := stripANSI(m.renderHealth())
+	for _, want := range []string{"Health (1/2 available)", "✗ makemkvcon", "required", "not found in PATH", "✓ mkvmerge"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("renderHealth() missing %q:\n%s", want, out)
+		}
+	}
+}
+
+// TestHealthView_FetchesDependencies enters the health view and checks it
+// shows /api/dependencies over the polled status, falls back to the status
+// when a fetch fails, and stops asking a daemon without the endpoint.
+func TestHealthView_FetchesDependencies(t *testing.T) {
+	fake := (&spindletest.FakeClient{}).
+		ScriptDependencies([]spindle.DependencyStatus{{Name: "drapto", Available: true}}, nil).
+		ScriptDependencies(nil, errors.New("connection refused")).
+		ScriptDependencies(nil, spindle.ErrDependenciesUnsupported)
+	now := time.Unix(1000, 0)
+	m := New(Options{ThemeName: "slate", Client: fake})
+	m.now = func() time.Time { return now }
+	m.width, m.height = 100, 20
+	m.snapshot.Status.Dependencies = []spindle.DependencyStatus{{Name: "makemkvcon"}}
+
+	updated, cmd := m.Update(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	m = updated.(Model)
+	if m.currentView != ViewHealth || cmd == nil {
+		t.Fatalf("entering health: view = %v, cmd = %v, want a dependency fetch", m.currentView, cmd)
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if out := stripANSI(m.renderHealth()); !strings.Contains(out, "drapto") || strings.Contains(out, "makemkvcon") {
+		t.Fatalf("renderHealth() after fetch:\n%s", out)
+	}
+
+	if cmd := m.refreshDependencies(); cmd != nil {
+		t.Fatal("refreshDependencies() refetched within the refresh interval")
+	}
+	now = now.Add(healthRefreshInterval)
+	updated, _ = m.Update(m.refreshDependencies()())
+	m = updated.(Model)
+	if out := stripANSI(m.renderHealth()); !strings.Contains(out, "makemkvcon") {
+		t.Fatalf("renderHealth() after a failed fetch did not fall back to the status:\n%s", out)
+	}
+
+	now = now.Add(healthRefreshInterval)
+	updated, _ = m.Update(m.refreshDependencies()())
+	m = updated.(Model)
+	now = now.Add(healthRefreshInterval)
+	if cmd := m.refreshDependencies(); cmd != nil {
+		t.Fatal("refreshDependencies() asked a daemon without /api/dependencies again")
+	}
+	if got := fake.Calls("FetchDependencies"); got != 3 {
+		t.Fatalf("FetchDependencies calls = %d, want 3", got)
+	}
+}
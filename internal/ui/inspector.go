@@ -1,13 +1,16 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"time"
 
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/viewport"
 	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/five82/flyer/internal/spindle"
 )
@@ -32,9 +35,12 @@ func (m *Model) getInspectedItem() *spindle.QueueItem {
 // openInspector opens the full-screen inspector for the current selection.
 func (m Model) openInspector(tab inspectorTab) (tea.Model, tea.Cmd) {
 	var item *spindle.QueueItem
-	if m.currentView == ViewProblems {
+	switch m.currentView {
+	case ViewProblems:
 		item = m.getTriageItem()
-	} else {
+	case ViewCompleted:
+		item = m.getCompletedItem()
+	default:
 		item = m.getSelectedItem()
 	}
 	if item == nil {
@@ -60,17 +66,14 @@ func (m Model) switchInspectorTab(tab inspectorTab) (tea.Model, tea.Cmd) {
 
 	switch tab {
 	case tabLogs:
-		if m.logState.mode != logSourceItem {
-			m.logState.mode = logSourceItem
-			m.logState.rawLines = nil
-			m.logState.itemCursor = 0
-			m.logState.lastItemID = 0 // Force reset in fetchItemLogs
-			m.clearLogSearch()
-			m.logState.contentVersion++
-		}
+		m.useItemLogs()
+		m.logState.lastInput = m.clock()
 		m.updateLogViewport()
 		return m, m.refreshLogs(item)
 	case tabProblems:
+		if item != nil {
+			m.beginProblemsVisit(item.ID)
+		}
 		m.inspectorViewport.GotoTop()
 		m.updateInspectorViewport()
 		return m, m.refreshProblemsLogs(item)
@@ -81,6 +84,20 @@ func (m Model) switchInspectorTab(tab inspectorTab) (tea.Model, tea.Cmd) {
 	}
 }
 
+// useItemLogs switches the log state to per-item logs, dropping the
+// buffer of any other source.
+func (m *Model) useItemLogs() {
+	if m.logState.mode == logSourceItem {
+		return
+	}
+	m.logState.mode = logSourceItem
+	m.logState.rawLines = nil
+	m.logState.itemCursor = 0
+	m.logState.lastItemID = 0 // Force reset in fetchItemLogs
+	m.clearLogSearch()
+	m.logState.contentVersion++
+}
+
 // handleInspectorKey processes keyboard input while the inspector is open.
 func (m Model) handleInspectorKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	switch {
@@ -184,6 +201,7 @@ func (m *Model) updateInspectorViewport() {
 	m.inspectorViewport.SetHeight(m.inspectorViewportHeight())
 
 	item := m.getInspectedItem()
+	m.inspectorBuilt = m.inspectorKey(item)
 	if item == nil {
 		m.inspectorViewport.SetContent(m.theme.Styles().MutedText.Render("Item no longer in queue"))
 		return
@@ -199,6 +217,59 @@ func (m *Model) updateInspectorViewport() {
 	}
 }
 
+// detailKey identifies what the inspector viewport was last built from.
+// Time-driven redraws (the detail refresh tick) and key presses go through
+// updateInspectorViewport and always rebuild.
+type detailKey struct {
+	itemID     int64
+	updatedAt  string
+	hash       uint64 // content hash of the item, catching changes UpdatedAt misses
+	duplicates int
+	tab        inspectorTab
+	width      int
+	theme      string
+}
+
+// inspectorKey returns the detail key for rendering item now; the zero
+// key when there is no item.
+func (m *Model) inspectorKey(item *spindle.QueueItem) detailKey {
+	if item == nil {
+		return detailKey{}
+	}
+	return detailKey{
+		itemID:     item.ID,
+		updatedAt:  item.UpdatedAt,
+		hash:       itemHash(*item),
+		duplicates: len(m.duplicates[item.ID]),
+		tab:        m.inspectorTab,
+		width:      m.width,
+		theme:      m.theme.Name,
+	}
+}
+
+// itemHash hashes the item's JSON encoding: nested pointers differ between
+// snapshots, so the encoded content is what identifies it.
+func itemHash(item spindle.QueueItem) uint64 {
+	data, _ := json.Marshal(item)
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return h.Sum64()
+}
+
+// refreshInspectorViewport rebuilds the inspector viewport only when the
+// inspected item changed since the last build, so polls that leave it
+// untouched reuse the rendered content.
+func (m *Model) refreshInspectorViewport() {
+	if !m.inspecting || m.inspectorViewport.Width() == 0 {
+		m.updateInspectorViewport()
+		return
+	}
+	if k := m.inspectorKey(m.getInspectedItem()); k != (detailKey{}) && k == m.inspectorBuilt {
+		return
+	}
+	m.updateInspectorViewport()
+}
+
 // renderInspector renders the full inspector: item band, tab band, and the
 // active tab's content in a Level 1 panel.
 func (m Model) renderInspector() string {
@@ -221,6 +292,11 @@ func (m Model) renderInspector() string {
 		if m.inspectorViewport.TotalLineCount() > m.inspectorViewport.VisibleLineCount() {
 			footer = fmt.Sprintf("%d%%", int(m.inspectorViewport.ScrollPercent()*100))
 		}
+		if item := m.getInspectedItem(); m.prefs.DetailFooter && m.inspectorTab == tabOverview && item != nil {
+			// Leave room for the scroll percent and the border corners.
+			stats := ansi.Truncate(m.detailFooterStats(*item), m.width-len(footer)-12, "…")
+			footer = strings.TrimSuffix(stats+" │ "+footer, " │ ")
+		}
 		b.WriteString(renderPanel(title, m.inspectorViewport.View(), footer, m.width, styles))
 	}
 	return b.String()
@@ -232,8 +308,11 @@ func (m Model) renderInspector() string {
 // (runtime first, then year, age, id, chips) instead of cropping.
 func (m Model) renderInspectorItemLine(styles Styles) string {
 	crumb := "Queue"
-	if m.returnView == ViewProblems {
+	switch m.returnView {
+	case ViewProblems:
 		crumb = "Problems"
+	case ViewCompleted:
+		crumb = "Completed"
 	}
 	prefix := styles.FaintText.Render(crumb + " › ")
 
@@ -242,15 +321,15 @@ func (m Model) renderInspectorItemLine(styles Styles) string {
 		return prefix + styles.MutedText.Render(fmt.Sprintf("Item #%d (gone)", m.inspectedID))
 	}
 
-	title := composeTitle(*item)
+	title := item.FullTitle()
 	parts := []headerPart{{prefix + styles.Text.Bold(true).Render(title), 0}}
 	// Year and runtime are identity, not metadata. The display title
 	// usually embeds the year already; only fill the gap when it doesn't.
-	if year := metadataYear(item.Metadata); year != "" && !strings.Contains(title, year) {
+	if year := metadataYear(*item); year != "" && !strings.Contains(title, year) {
 		parts = append(parts, headerPart{styles.MutedText.Render("(" + year + ")"), 4})
 	}
 	if item.Source != nil && item.Source.DurationSeconds > 0 {
-		runtime := humanizeDurationLong(time.Duration(item.Source.DurationSeconds) * time.Second)
+		runtime := humanizeSpan(time.Duration(item.Source.DurationSeconds)*time.Second, time.Minute, 2)
 		parts = append(parts, headerPart{styles.MutedText.Render(runtime), 5})
 	}
 	parts = append(parts,
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
 )
 
 func inspectorModelFor(item spindle.QueueItem) Model {
@@ -92,3 +94,121 @@ func TestStatusChips_StoppedItem(t *testing.T) {
 		t.Fatalf("user-stopped item missing STOPPED chip, got %q", got)
 	}
 }
+
+func TestStatusChips_StalledItem(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	item := spindle.QueueItem{
+		ID:        7,
+		Stage:     "encoding",
+		Tasks:     []spindle.Task{{Type: "encoding", State: "running"}},
+		UpdatedAt: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	}
+	if got := stripANSI(m.renderStatusChips(item, m.theme.Styles())); !strings.Contains(got, "STALLED") {
+		t.Fatalf("stalled item missing STALLED chip, got %q", got)
+	}
+
+	item.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	if got := stripANSI(m.renderStatusChips(item, m.theme.Styles())); strings.Contains(got, "STALLED") {
+		t.Fatalf("fresh item must not show STALLED, got %q", got)
+	}
+}
+
+func TestDetailFooterStats(t *testing.T) {
+	now := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	encoding := func(pct float64) []spindle.Task {
+		return []spindle.Task{
+			{Type: "ripping", State: "done"},
+			{Type: "encoding", State: "running", StartedAt: now.Add(-10 * time.Minute).Format(time.RFC3339), Progress: spindle.TaskProgress{Percent: pct}},
+		}
+	}
+
+	movie := spindle.QueueItem{ID: 1, Stage: "encoding", Tasks: encoding(42), Encoding: &spindle.EncodingStatus{ETASeconds: 720}}
+	episodes := make([]spindle.EpisodeStatus, 8)
+	for i := range 3 {
+		episodes[i].FinalPath = "/library/show.mkv"
+	}
+	tv := spindle.QueueItem{ID: 2, Stage: "encoding", Tasks: encoding(50), Episodes: episodes}
+	waiting := spindle.QueueItem{ID: 3, Stage: "identification"}
+
+	cases := map[string]struct {
+		item spindle.QueueItem
+		want string
+	}{
+		"movie":   {movie, "encoding · Running · 42% · ETA 12m 0s"},
+		"tv":      {tv, "encoding · Running · 50% · ETA 10m 0s · 3/8 eps"},
+		"waiting": {waiting, "waiting · Waiting"},
+	}
+	for name, tc := range cases {
+		m := inspectorModelFor(tc.item)
+		m.now = func() time.Time { return now }
+		if got := m.detailFooterStats(tc.item); got != tc.want {
+			t.Errorf("%s: detailFooterStats() = %q, want %q", name, got, tc.want)
+		}
+	}
+}
+
+func TestRenderInspector_DetailFooterPref(t *testing.T) {
+	item := spindle.QueueItem{ID: 1, Stage: "identification"}
+	m := inspectorModelFor(item)
+	m.height = 30
+	m.inspecting = true
+	m.updateInspectorViewport()
+	if got := stripANSI(m.renderInspector()); strings.Contains(got, "waiting · Waiting") {
+		t.Fatalf("footer stats shown with the pref off:\n%s", got)
+	}
+
+	m.prefs.DetailFooter = true
+	lines := strings.Split(stripANSI(m.renderInspector()), "\n")
+	if last := lines[len(lines)-1]; !strings.Contains(last, "waiting · Waiting") {
+		t.Fatalf("panel bottom border = %q, want footer stats", last)
+	}
+}
+
+func TestItemHash_TracksContent(t *testing.T) {
+	item := spindle.QueueItem{ID: 4, Stage: "encoding", Encoding: &spindle.EncodingStatus{Percent: 40}}
+	same := item
+	same.Encoding = &spindle.EncodingStatus{Percent: 40}
+	if itemHash(item) != itemHash(same) {
+		t.Fatalf("equal items in fresh pointers must hash alike")
+	}
+	same.Encoding.Percent = 41
+	if itemHash(item) == itemHash(same) {
+		t.Fatalf("a nested progress change must change the hash")
+	}
+}
+
+func TestApplySnapshot_ReusesUnchangedDetail(t *testing.T) {
+	item := spindle.QueueItem{ID: 4, Stage: "encoding", UpdatedAt: "2026-01-02T10:00:00Z",
+		Encoding: &spindle.EncodingStatus{Percent: 40}}
+	m := inspectorModelFor(item)
+	m.height = 40
+	m.inspecting = true
+	m.updateInspectorViewport()
+
+	const stale = "stale content"
+	m.inspectorViewport.SetContent(stale)
+
+	// A new snapshot holding an identical copy of the item reuses the content.
+	copied := item
+	copied.Encoding = &spindle.EncodingStatus{Percent: 40}
+	m.applySnapshot(state.Snapshot{Version: 2, Queue: []spindle.QueueItem{copied}})
+	if got := m.inspectorViewport.GetContent(); got != stale {
+		t.Fatalf("unchanged item rebuilt the detail:\n%s", got)
+	}
+
+	// A changed item rebuilds it, even when UpdatedAt did not move.
+	changed := copied
+	changed.Encoding = &spindle.EncodingStatus{Percent: 55}
+	m.applySnapshot(state.Snapshot{Version: 3, Queue: []spindle.QueueItem{changed}})
+	if got := m.inspectorViewport.GetContent(); got == stale {
+		t.Fatalf("changed item did not rebuild the detail:\n%s", got)
+	}
+
+	// Switching tabs always rebuilds.
+	m.inspectorViewport.SetContent(stale)
+	m.inspectorTab = tabEpisodes
+	m.applySnapshot(state.Snapshot{Version: 4, Queue: []spindle.QueueItem{changed}})
+	if got := m.inspectorViewport.GetContent(); got == stale {
+		t.Fatalf("tab change reused the overview content")
+	}
+}
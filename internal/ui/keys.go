@@ -14,6 +14,10 @@ type keyMap struct {
 	ViewQueue      key.Binding
 	ViewDaemonLogs key.Binding
 	ViewProblems   key.Binding
+	ViewCompleted  key.Binding
+	ViewStats      key.Binding
+	ViewHealth     key.Binding
+	ViewReasons    key.Binding
 
 	// Data refresh
 	Refresh key.Binding
@@ -30,8 +34,21 @@ type keyMap struct {
 
 	// Queue actions
 	CycleFilter    key.Binding
+	CycleSort      key.Binding
+	JumpToItem     key.Binding
+	ExportQueue    key.Binding
+	OpenOutput     key.Binding
+	CopyReport     key.Binding
+	TogglePin      key.Binding
+	ToggleTimes    key.Binding
+	WatchItem      key.Binding
+	ReverseSort    key.Binding
 	Filter         key.Binding
 	ToggleEpisodes key.Binding
+	ToggleLanes    key.Binding
+	CollapseLane   key.Binding
+	NextLane       key.Binding
+	PrevLane       key.Binding
 
 	// Navigation
 	Up           key.Binding
@@ -44,11 +61,22 @@ type keyMap struct {
 	HalfPageDown key.Binding
 
 	// Logs actions
-	ToggleFollow key.Binding
-	Search       key.Binding
-	NextMatch    key.Binding
-	PrevMatch    key.Binding
-	LogFilters   key.Binding
+	ToggleFollow  key.Binding
+	Search        key.Binding
+	NextMatch     key.Binding
+	PrevMatch     key.Binding
+	FirstMatch    key.Binding
+	LastMatch     key.Binding
+	SearchCase    key.Binding
+	SearchWord    key.Binding
+	LogFilters    key.Binding
+	CycleLogLevel key.Binding
+	ExportLogs    key.Binding
+	AllItemLogs   key.Binding
+	ToggleLogWrap key.Binding
+	NextProblem   key.Binding
+	PrevProblem   key.Binding
+	FocusLogItem  key.Binding
 
 	// Search/input
 	Confirm key.Binding
@@ -88,6 +116,22 @@ func DefaultKeyMap() keyMap {
 			key.WithKeys("p", "P"),
 			key.WithHelp("p", "Problems"),
 		),
+		ViewCompleted: key.NewBinding(
+			key.WithKeys("c", "C"),
+			key.WithHelp("c", "Completed recently"),
+		),
+		ViewStats: key.NewBinding(
+			key.WithKeys("a", "A"),
+			key.WithHelp("a", "Encode stats"),
+		),
+		ViewHealth: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "Dependency health"),
+		),
+		ViewReasons: key.NewBinding(
+			key.WithKeys("b", "B"),
+			key.WithHelp("b", "Review reasons"),
+		),
 
 		// Data refresh
 		Refresh: key.NewBinding(
@@ -134,6 +178,42 @@ func DefaultKeyMap() keyMap {
 			key.WithKeys("f", "F"),
 			key.WithHelp("f", "Cycle filter"),
 		),
+		CycleSort: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "Cycle sort"),
+		),
+		JumpToItem: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "Jump to item #"),
+		),
+		ExportQueue: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "Export queue"),
+		),
+		OpenOutput: key.NewBinding(
+			key.WithKeys("o", "O"),
+			key.WithHelp("o", "Open final file"),
+		),
+		CopyReport: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "Copy problem report"),
+		),
+		TogglePin: key.NewBinding(
+			key.WithKeys("*"),
+			key.WithHelp("*", "Pin/unpin item"),
+		),
+		ToggleTimes: key.NewBinding(
+			key.WithKeys("u", "U"),
+			key.WithHelp("u", "Relative/absolute times"),
+		),
+		WatchItem: key.NewBinding(
+			key.WithKeys("w", "W"),
+			key.WithHelp("w", "Watch item fullscreen"),
+		),
+		ReverseSort: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "Reverse sort"),
+		),
 		Filter: key.NewBinding(
 			key.WithKeys("/"),
 			key.WithHelp("/", "Filter by title"),
@@ -143,6 +223,22 @@ func DefaultKeyMap() keyMap {
 			key.WithKeys("t"),
 			key.WithHelp("t", "Toggle episodes"),
 		),
+		ToggleLanes: key.NewBinding(
+			key.WithKeys("v", "V"),
+			key.WithHelp("v", "Group by lane"),
+		),
+		CollapseLane: key.NewBinding(
+			key.WithKeys("z", "Z"),
+			key.WithHelp("z", "Collapse lane"),
+		),
+		NextLane: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "Next lane"),
+		),
+		PrevLane: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "Previous lane"),
+		),
 
 		// Navigation
 		Up: key.NewBinding(
@@ -199,6 +295,50 @@ func DefaultKeyMap() keyMap {
 			key.WithKeys("f", "F"),
 			key.WithHelp("f", "Log filters"),
 		),
+		FirstMatch: key.NewBinding(
+			key.WithKeys("<"),
+			key.WithHelp("<", "First match"),
+		),
+		LastMatch: key.NewBinding(
+			key.WithKeys(">"),
+			key.WithHelp(">", "Last match"),
+		),
+		CycleLogLevel: key.NewBinding(
+			key.WithKeys("e", "E"),
+			key.WithHelp("e", "Cycle level filter"),
+		),
+		SearchCase: key.NewBinding(
+			key.WithKeys("alt+c"),
+			key.WithHelp("alt+c", "Toggle case-sensitive search"),
+		),
+		SearchWord: key.NewBinding(
+			key.WithKeys("alt+w"),
+			key.WithHelp("alt+w", "Toggle whole-word search"),
+		),
+		ExportLogs: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "Export log buffer"),
+		),
+		AllItemLogs: key.NewBinding(
+			key.WithKeys("m", "M"),
+			key.WithHelp("m", "Toggle all-items log"),
+		),
+		NextProblem: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "Next warning/error line"),
+		),
+		PrevProblem: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "Previous warning/error line"),
+		),
+		ToggleLogWrap: key.NewBinding(
+			key.WithKeys("w", "W"),
+			key.WithHelp("w", "Toggle line wrap"),
+		),
+		FocusLogItem: key.NewBinding(
+			key.WithKeys("o", "O"),
+			key.WithHelp("o", "Cycle focused item (all-items log)"),
+		),
 
 		// Search/input
 		Confirm: key.NewBinding(
@@ -220,7 +360,7 @@ func (k keyMap) HelpSections() []HelpSection {
 		{
 			Title: "Views",
 			Bindings: []key.Binding{
-				k.ViewQueue, k.ViewDaemonLogs, k.ViewProblems, k.Escape,
+				k.ViewQueue, k.ViewDaemonLogs, k.ViewProblems, k.ViewCompleted, k.ViewStats, k.ViewHealth, k.ViewReasons, k.Escape,
 			},
 		},
 		{
@@ -237,11 +377,11 @@ func (k keyMap) HelpSections() []HelpSection {
 		},
 		{
 			Title:    "Queue",
-			Bindings: []key.Binding{k.Filter, k.CycleFilter, k.ToggleEpisodes},
+			Bindings: []key.Binding{k.Filter, k.CycleFilter, k.CycleSort, k.ReverseSort, k.JumpToItem, k.ExportQueue, k.OpenOutput, k.CopyReport, k.TogglePin, k.ToggleTimes, k.WatchItem, k.ToggleEpisodes, k.ToggleLanes, k.CollapseLane, k.NextLane},
 		},
 		{
 			Title:    "Logs",
-			Bindings: []key.Binding{k.ToggleFollow, k.Search, k.NextMatch, k.PrevMatch, k.LogFilters},
+			Bindings: []key.Binding{k.ToggleFollow, k.Search, k.NextMatch, k.PrevMatch, k.FirstMatch, k.LastMatch, k.NextProblem, k.PrevProblem, k.SearchCase, k.SearchWord, k.CycleLogLevel, k.LogFilters, k.ExportLogs, k.ToggleLogWrap, k.AllItemLogs, k.FocusLogItem},
 		},
 		{
 			Title:    "General",
@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/five82/flyer/internal/prefs"
+)
+
+// logHighlight is a compiled always-on log highlight from prefs.
+type logHighlight struct {
+	re    *regexp.Regexp
+	color string
+}
+
+// compileLogHighlights compiles the configured highlight patterns. Blank
+// and invalid patterns are skipped; each invalid one yields a warning for
+// the operator.
+func compileLogHighlights(patterns []prefs.LogHighlight) ([]logHighlight, []string) {
+	var highlights []logHighlight
+	var warnings []string
+	for _, p := range patterns {
+		pattern := strings.TrimSpace(p.Pattern)
+		if pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("Skipped log highlight %q: invalid pattern", pattern))
+			continue
+		}
+		highlights = append(highlights, logHighlight{re: re, color: strings.TrimSpace(p.Color)})
+	}
+	return highlights, warnings
+}
+
+// logHighlightStyle resolves a highlight color: a theme role name follows
+// the active theme, anything else is taken as a literal color.
+func logHighlightStyle(color string, styles Styles) lipgloss.Style {
+	switch role := strings.ToLower(color); role {
+	case "", "accent":
+		return styles.AccentText.Bold(true)
+	case "info", "warning", "success", "danger":
+		return roleStyle(role, styles).Bold(true)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Bold(true)
+}
+
+// applyLogHighlights renders text in the base style with every configured
+// highlight match in its pattern's color. Earlier patterns win where
+// matches overlap.
+func (m *Model) applyLogHighlights(text string, base lipgloss.Style, styles Styles) string {
+	return m.markLogText(text, base, styles, nil, true)
+}
+
+// searchMark styles the substrings of a log line that match the active
+// search.
+type searchMark struct {
+	re    *regexp.Regexp
+	style lipgloss.Style
+}
+
+// searchSpans returns the [start, end) byte spans of re's non-empty matches
+// in text; zero-width matches have nothing to highlight.
+func searchSpans(re *regexp.Regexp, text string) [][]int {
+	var spans [][]int
+	for _, loc := range re.FindAllStringIndex(text, -1) {
+		if loc[1] > loc[0] {
+			spans = append(spans, loc)
+		}
+	}
+	return spans
+}
+
+// markLogText renders text in the base style with search matches in the
+// search mark's style and, when withHighlights is set, configured highlight
+// matches in their colors. Search matches win over highlights, and earlier
+// highlight patterns over later ones.
+func (m *Model) markLogText(text string, base lipgloss.Style, styles Styles, search *searchMark, withHighlights bool) string {
+	if text == "" {
+		return base.Render(text)
+	}
+
+	var spanStyles []lipgloss.Style
+	// owner[i] is 1 + the index into spanStyles covering byte i, 0 for none.
+	owner := make([]int, len(text))
+	claim := func(spans [][]int, style lipgloss.Style) {
+		spanStyles = append(spanStyles, style)
+		for _, loc := range spans {
+			for j := loc[0]; j < loc[1]; j++ {
+				if owner[j] == 0 {
+					owner[j] = len(spanStyles)
+				}
+			}
+		}
+	}
+	if search != nil && search.re != nil {
+		claim(searchSpans(search.re, text), search.style)
+	}
+	if withHighlights {
+		for _, h := range m.logHighlights {
+			claim(h.re.FindAllStringIndex(text, -1), logHighlightStyle(h.color, styles))
+		}
+	}
+	if len(spanStyles) == 0 {
+		return base.Render(text)
+	}
+
+	var b strings.Builder
+	start := 0
+	for i := 1; i <= len(text); i++ {
+		if i < len(text) && owner[i] == owner[start] {
+			continue
+		}
+		segment := text[start:i]
+		if o := owner[start]; o > 0 {
+			b.WriteString(spanStyles[o-1].Render(segment))
+		} else {
+			b.WriteString(base.Render(segment))
+		}
+		start = i
+	}
+	return b.String()
+}
@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/five82/flyer/internal/prefs"
+	"github.com/five82/flyer/internal/spindle"
+)
+
+func TestApplyLogHighlights_StylesMatches(t *testing.T) {
+	theme := GetTheme("slate")
+	styles := theme.Styles()
+	highlights, warnings := compileLogHighlights([]prefs.LogHighlight{{Pattern: "GPU", Color: "warning"}})
+	if len(warnings) != 0 {
+		t.Fatalf("warnings = %v, want none", warnings)
+	}
+	m := &Model{theme: theme, logHighlights: highlights}
+
+	text := "GPU encode started"
+	got := m.applyLogHighlights(text, styles.Text, styles)
+	if stripANSI(got) != text {
+		t.Fatalf("applyLogHighlights() text = %q, want %q", stripANSI(got), text)
+	}
+	want := logHighlightStyle("warning", styles).Render("GPU") + styles.Text.Render(" encode started")
+	if got != want {
+		t.Fatalf("applyLogHighlights() = %q, want %q", got, want)
+	}
+
+	plain := (&Model{theme: theme}).applyLogHighlights(text, styles.Text, styles)
+	if plain != styles.Text.Render(text) {
+		t.Fatalf("applyLogHighlights() without highlights = %q, want base style", plain)
+	}
+}
+
+func TestApplyLogHighlights_EarlierPatternWinsOverlap(t *testing.T) {
+	theme := GetTheme("slate")
+	styles := theme.Styles()
+	highlights, _ := compileLogHighlights([]prefs.LogHighlight{
+		{Pattern: "GPU", Color: "danger"},
+		{Pattern: "GPU encode", Color: "info"},
+	})
+	m := &Model{theme: theme, logHighlights: highlights}
+
+	got := m.applyLogHighlights("GPU encode", styles.Text, styles)
+	want := logHighlightStyle("danger", styles).Render("GPU") + logHighlightStyle("info", styles).Render(" encode")
+	if got != want {
+		t.Fatalf("applyLogHighlights() = %q, want %q", got, want)
+	}
+}
+
+func TestStyleLogEvent_AppliesHighlights(t *testing.T) {
+	theme := GetTheme("slate")
+	styles := theme.Styles()
+	highlights, _ := compileLogHighlights([]prefs.LogHighlight{{Pattern: `Item #42`}})
+	m := &Model{theme: theme, logHighlights: highlights}
+
+	evt := spindle.LogEvent{Level: "info", Message: "ripping", ItemID: 42, Stage: "ripping"}
+	got := m.styleLogEvent(evt, styles, false)
+	if !strings.Contains(got, logHighlightStyle("", styles).Render("Item #42")) {
+		t.Fatalf("styleLogEvent() = %q, want highlighted subject", got)
+	}
+}
+
+func TestCompileLogHighlights_SkipsInvalidWithWarning(t *testing.T) {
+	highlights, warnings := compileLogHighlights([]prefs.LogHighlight{
+		{Pattern: "GPU"},
+		{Pattern: "(unclosed"},
+		{Pattern: "  "},
+	})
+	if len(highlights) != 1 || highlights[0].re.String() != "GPU" {
+		t.Fatalf("highlights = %+v, want only GPU", highlights)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "(unclosed") {
+		t.Fatalf("warnings = %v, want one warning naming the invalid pattern", warnings)
+	}
+
+	m := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{LogHighlights: []prefs.LogHighlight{{Pattern: "(unclosed"}}}})
+	if !strings.Contains(m.errorMsg, "(unclosed") {
+		t.Fatalf("errorMsg = %q, want invalid highlight warning", m.errorMsg)
+	}
+}
+
+func TestSearchSpans_ByteSpans(t *testing.T) {
+	tests := []struct {
+		pattern, text string
+		want          [][]int
+	}{
+		{"(?i)error", "Error: disk error", [][]int{{0, 5}, {12, 17}}},
+		{"é+", "café ok", [][]int{{3, 5}}}, // byte offsets, not runes
+		{"x*", "abc", nil},                 // zero-width matches are dropped
+		{"gpu", "encode", nil},
+	}
+	for _, tt := range tests {
+		got := searchSpans(regexp.MustCompile(tt.pattern), tt.text)
+		if !slices.EqualFunc(got, tt.want, slices.Equal[[]int]) {
+			t.Errorf("searchSpans(%q, %q) = %v, want %v", tt.pattern, tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestMarkLogText_SearchWinsOverHighlights(t *testing.T) {
+	theme := GetTheme("slate")
+	styles := theme.Styles()
+	highlights, _ := compileLogHighlights([]prefs.LogHighlight{{Pattern: "GPU encode", Color: "info"}})
+	m := &Model{theme: theme, logHighlights: highlights}
+	mark := &searchMark{re: regexp.MustCompile("encode"), style: styles.AccentText.Reverse(true)}
+
+	got := m.markLogText("GPU encode done", styles.Text, styles, mark, true)
+	want := logHighlightStyle("info", styles).Render("GPU ") +
+		mark.style.Render("encode") +
+		styles.Text.Render(" done")
+	if got != want {
+		t.Fatalf("markLogText() = %q, want %q", got, want)
+	}
+
+	// Without highlights only the search spans are marked; text outside
+	// them keeps the base style.
+	got = m.markLogText("encode, re-encode", styles.Text, styles, mark, false)
+	want = mark.style.Render("encode") + styles.Text.Render(", re-") + mark.style.Render("encode")
+	if got != want {
+		t.Fatalf("markLogText(no highlights) = %q, want %q", got, want)
+	}
+}
+
+func TestStyleLogEventMarked_MarksMatchesInSegments(t *testing.T) {
+	theme := GetTheme("slate")
+	styles := theme.Styles()
+	m := &Model{theme: theme}
+	mark := &searchMark{re: regexp.MustCompile("(?i)retry"), style: styles.AccentText.Reverse(true)}
+
+	evt := spindle.LogEvent{Level: "warn", Message: "read retry 2", Fields: map[string]string{"error_hint": "retry later"}}
+	got := m.styleLogEventMarked(evt, styles, false, mark)
+	if stripANSI(got) != stripANSI(m.styleLogEvent(evt, styles, false)) {
+		t.Fatalf("marked text = %q, want the unmarked text", stripANSI(got))
+	}
+	if n := strings.Count(got, mark.style.Render("retry")); n != 2 {
+		t.Fatalf("marked spans = %d, want 2 (message and field value): %q", n, got)
+	}
+	if !strings.Contains(got, styles.Text.Render("read ")) {
+		t.Fatalf("text around the match lost its base style: %q", got)
+	}
+}
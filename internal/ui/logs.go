@@ -3,17 +3,24 @@ package ui
 import (
 	"context"
 	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/textinput"
 	"charm.land/bubbles/v2/viewport"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
 
+	"github.com/five82/flyer/internal/export"
 	"github.com/five82/flyer/internal/spindle"
 )
 
@@ -23,6 +30,7 @@ type logSource int
 const (
 	logSourceDaemon logSource = iota
 	logSourceItem
+	logSourceAll // daemon and every item's logs interleaved (m)
 )
 
 // Log refresh constants
@@ -43,25 +51,47 @@ type logState struct {
 	rawLines    []spindle.LogEvent
 	follow      bool
 	lastRefresh time.Time
+	lastInput   time.Time // last log-view keypress, for idle follow resume
 
 	// Cursors for incremental fetching
 	streamCursor uint64
 	itemCursor   uint64 // Changed to uint64 for /api/logs cursor
 	lastItemID   int64  // Track which item the cursor belongs to
 
+	// rowOffsets maps each rawLines index to the viewport row its block
+	// starts on, recorded by renderLogContent so match scrolling accounts
+	// for field rows, collapsed repeats and wrapped lines.
+	rowOffsets []int
+
+	// problemLines holds the rawLines indices at WARN or worse, rebuilt
+	// whenever the content re-renders; problemLine is the one ] or [ last
+	// jumped to (-1 for none).
+	problemLines   []int
+	problemLine    int
+	problemWrapped string // "top" or "bottom" after ]/[ wrapped
+
+	// focusItem is the item whose lines are emphasized in all-items mode
+	// (cycled with o); 0 emphasizes nothing.
+	focusItem int64
+
 	// Filters (apply to both daemon and item logs via /api/logs)
 	filterLevel     string
 	filterComponent string
 	filterLane      string
 	filterRequest   string
+	filterFrom      logTimeFilter
+	filterTo        logTimeFilter
 
 	// Search
 	searchActive   bool
 	searchQuery    string
 	searchRegex    *regexp.Regexp
 	searchInput    textinput.Model
-	searchMatches  []int // Line indices that match
-	searchMatchIdx int   // Current match index
+	searchMatches  []int  // Line indices that match
+	searchMatchIdx int    // Current match index
+	searchWrapped  string // "top" or "bottom" after n/N wrapped; cleared by the next jump
+	searchCase     bool   // case-sensitive matching (alt+c)
+	searchWord     bool   // whole-word matching (alt+w)
 
 	// Content caching - skip re-render when unchanged
 	contentVersion uint64
@@ -76,9 +106,11 @@ func (m *Model) initLogState() {
 
 	m.logState = logState{
 		mode:           logSourceDaemon,
-		follow:         true,
+		follow:         !m.prefs.LogFollowPaused,
+		problemLine:    -1,
 		contentVersion: 1,      // Start at 1 so first increment (to 2) differs from initial render (lastRendered=1)
 		filterLevel:    "info", // Default to INFO to hide DEBUG noise
+		lastInput:      m.clock(),
 	}
 	m.logState.searchInput = ti
 }
@@ -114,6 +146,10 @@ func (m *Model) updateLogViewport() {
 
 	// Only re-render content if it changed (version mismatch or first render)
 	if m.logState.lastRendered == 0 || m.logState.contentVersion != m.logState.lastRendered {
+		m.logState.problemLines = logProblemLines(m.logState.rawLines)
+		if m.logState.problemLine >= len(m.logState.rawLines) {
+			m.logState.problemLine = -1
+		}
 		content := m.renderLogContent()
 		m.logViewport.SetContent(content)
 		m.logState.lastRendered = m.logState.contentVersion
@@ -139,10 +175,14 @@ func (m Model) renderLogs() string {
 // only ever shows the daemon log; item logs are rendered by the per-item
 // inspector instead.
 func (m Model) getLogTitle() string {
+	title := "Daemon Log"
+	if m.logState.mode == logSourceAll {
+		title = "All Items Log"
+	}
 	if m.logFiltersActive() {
-		return "Daemon Log (filtered)"
+		return title + " (filtered)"
 	}
-	return "Daemon Log"
+	return title
 }
 
 // renderLogStatus renders the log status bar.
@@ -152,8 +192,10 @@ func (m *Model) renderLogStatus(styles Styles) string {
 		matchNum := m.logState.searchMatchIdx + 1
 		totalMatches := len(m.logState.searchMatches)
 		return styles.AccentText.Render(fmt.Sprintf("/%s", m.logState.searchQuery)) +
+			styles.MutedText.Render(m.searchFlagsLabel()) +
 			styles.FaintText.Render(" - ") +
 			styles.WarningText.Render(fmt.Sprintf("%d/%d", matchNum, totalMatches)) +
+			m.searchWrapLabel(styles) +
 			styles.FaintText.Render(" - Press ") +
 			styles.AccentText.Render("n") +
 			styles.FaintText.Render(" for next, ") +
@@ -165,7 +207,8 @@ func (m *Model) renderLogStatus(styles Styles) string {
 
 	// If search regex exists but no matches
 	if m.logState.searchRegex != nil && len(m.logState.searchMatches) == 0 {
-		return styles.DangerText.Render("Pattern not found: " + m.logState.searchQuery)
+		return styles.DangerText.Render("Pattern not found: "+m.logState.searchQuery) +
+			styles.MutedText.Render(m.searchFlagsLabel())
 	}
 
 	// Source label
@@ -178,6 +221,9 @@ func (m *Model) renderLogStatus(styles Styles) string {
 		} else {
 			apiPath = "api logs"
 		}
+	case logSourceAll:
+		src = "All"
+		apiPath = "api logs"
 	default:
 		src = "Daemon"
 		apiPath = "api logs"
@@ -192,6 +238,12 @@ func (m *Model) renderLogStatus(styles Styles) string {
 
 	var parts []string
 	parts = append(parts, styles.FaintText.Render(status))
+	if label := m.problemStatusLabel(); label != "" {
+		parts = append(parts, styles.WarningText.Render(label))
+	}
+	if m.logState.mode == logSourceAll && m.logState.focusItem > 0 {
+		parts = append(parts, styles.InfoText.Render(fmt.Sprintf("focus #%d", m.logState.focusItem)))
+	}
 
 	// Scroll position while not following, so "where am I" stays visible.
 	if !m.logState.follow && m.logViewport.TotalLineCount() > m.logViewport.VisibleLineCount() {
@@ -200,15 +252,18 @@ func (m *Model) renderLogStatus(styles Styles) string {
 
 	// Search input mode
 	if m.logState.searchActive {
-		parts = append(parts, styles.AccentText.Render("search: "+m.logState.searchInput.Value()))
+		parts = append(parts, styles.AccentText.Render("search: "+m.logState.searchInput.Value())+
+			styles.MutedText.Render(m.searchFlagsLabel()))
 	}
 
-	// Filters
+	// Level (quick-cycled with e), then the other filters
+	level := "all"
+	if m.logState.filterLevel != "" {
+		level = strings.ToUpper(m.logState.filterLevel)
+	}
+	parts = append(parts, styles.MutedText.Render("level "+level))
 	if m.logFiltersActive() {
 		var filterParts []string
-		if m.logState.filterLevel != "" {
-			filterParts = append(filterParts, "level="+m.logState.filterLevel)
-		}
 		if m.logState.filterComponent != "" {
 			filterParts = append(filterParts, "comp="+m.logState.filterComponent)
 		}
@@ -218,6 +273,9 @@ func (m *Model) renderLogStatus(styles Styles) string {
 		if m.logState.filterRequest != "" {
 			filterParts = append(filterParts, "req="+m.logState.filterRequest)
 		}
+		if label := logTimeRangeLabel(m.logState.filterFrom.at, m.logState.filterTo.at); label != "" {
+			filterParts = append(filterParts, label)
+		}
 		if len(filterParts) > 0 {
 			parts = append(parts, styles.MutedText.Render("filter: "+strings.Join(filterParts, " ")))
 		}
@@ -238,6 +296,7 @@ func (m *Model) renderLogContent() string {
 	styles := m.theme.Styles()
 
 	if len(m.logState.rawLines) == 0 {
+		m.logState.rowOffsets = nil
 		return styles.MutedText.Render("No log entries")
 	}
 
@@ -251,56 +310,140 @@ func (m *Model) renderLogContent() string {
 		activeMatchLine = m.logState.searchMatches[m.logState.searchMatchIdx]
 	}
 
-	var b strings.Builder
+	runs := singleLogRuns(len(m.logState.rawLines))
+	if m.prefs.LogCollapseRepeats {
+		runs = collapseLogRepeats(m.logState.rawLines)
+	}
 
-	for i, evt := range m.logState.rawLines {
+	var b strings.Builder
+	offsets := make([]int, len(m.logState.rawLines))
+	row := 0
+
+	for r, run := range runs {
+		// A collapsed run shows its newest event and matches search when
+		// any of its events does.
+		i := run.end
+		evt := m.logState.rawLines[i]
 		lineNum := i + 1
 
 		// Determine if this line is a search match
-		isActiveMatch := i == activeMatchLine
-		isPassiveMatch := matchSet[i] && !isActiveMatch
+		isActiveMatch := activeMatchLine >= run.start && activeMatchLine <= run.end
+		isPassiveMatch := false
+		for j := run.start; j <= run.end && !isActiveMatch; j++ {
+			isPassiveMatch = isPassiveMatch || matchSet[j]
+		}
 
-		// Build line content: line number + styled text
-		var lineContent string
+		// Build line content: line number + styled text. Matching lines keep
+		// their syntax coloring with only the matched substrings marked, like
+		// less/grep; the line-number prefix carries the match state so a
+		// match spanning styled segments still shows.
+		prefix := fmt.Sprintf("%4d │ ", lineNum)
+		var body string
 		switch {
 		case isActiveMatch:
-			// Active match: full line (including the line-number prefix)
-			// highlighted with the warning background.
-			prefixStyle := lipgloss.NewStyle().
+			// Active match: line-number prefix and matches on the warning
+			// background.
+			activeStyle := lipgloss.NewStyle().
 				Background(lipgloss.Color(m.theme.Warning)).
 				Foreground(lipgloss.Color(m.theme.Background))
-			lineContent = prefixStyle.Render(fmt.Sprintf("%4d │ ", lineNum)) +
-				m.colorizeLineForSearch(formatLogEvent(evt), m.theme.Warning)
+			prefix = activeStyle.Render(prefix)
+			body = m.styleLogEventMarked(evt, styles, false, &searchMark{re: m.logState.searchRegex, style: activeStyle.Bold(true)})
 		case isPassiveMatch:
-			// Passive match: accent foreground
-			lineContent = styles.AccentText.Render(fmt.Sprintf("%4d │ ", lineNum)) +
-				m.colorizeLineWithHighlight(formatLogEvent(evt), styles)
+			// Passive match: accent prefix and reverse-video matches
+			prefix = styles.AccentText.Render(prefix)
+			body = m.styleLogEventMarked(evt, styles, false, &searchMark{re: m.logState.searchRegex, style: styles.AccentText.Reverse(true).Bold(true)})
+		case m.logState.problemLine >= run.start && m.logState.problemLine <= run.end:
+			// Problem line selected with ]/[: prefix in its level's color
+			prefix = m.getLevelStyle(normalizeLogLevel(evt.Level), styles).Reverse(true).Render(prefix)
+			body = m.styleLogEvent(evt, styles, false)
+		case m.logState.mode == logSourceAll && logLineFocused(evt, m.logState.focusItem):
+			// Focused item in the all-items view: emphasized prefix
+			prefix = styles.InfoText.Bold(true).Render(fmt.Sprintf("%4d ┃ ", lineNum))
+			body = m.styleLogEvent(evt, styles, false)
 		default:
 			// Normal line: styled directly from the structured event fields
-			lineContent = styles.FaintText.Render(fmt.Sprintf("%4d │ ", lineNum)) +
-				m.styleLogEvent(evt, styles, false)
+			prefix = styles.FaintText.Render(prefix)
+			body = m.styleLogEvent(evt, styles, false)
+		}
+		if n := run.end - run.start + 1; n > 1 {
+			body += styles.FaintText.Render(fmt.Sprintf(" (×%d)", n))
 		}
 
-		b.WriteString(lineContent)
-		if i < len(m.logState.rawLines)-1 {
+		// Wrapped blocks continue under a blank gutter so only the first
+		// row carries the line number.
+		block := strings.Split(prefix+body, "\n")
+		if m.prefs.LogWrap {
+			gutter := styles.FaintText.Render(strings.Repeat(" ", max(ansi.StringWidth(prefix)-2, 0)) + "│ ")
+			block = wrapLogBlock(prefix, body, gutter, panelInnerWidth(m.width))
+		}
+		for j := run.start; j <= run.end; j++ {
+			offsets[j] = row
+		}
+		row += len(block)
+
+		b.WriteString(strings.Join(block, "\n"))
+		if r < len(runs)-1 {
 			b.WriteString("\n")
 		}
 	}
 
+	m.logState.rowOffsets = offsets
 	return b.String()
 }
 
-// colorizeLineForSearch renders a line with search highlight background.
-func (m *Model) colorizeLineForSearch(line string, bgColor string) string {
-	style := lipgloss.NewStyle().
-		Background(lipgloss.Color(bgColor)).
-		Foreground(lipgloss.Color(m.theme.Background))
-	return style.Render(line)
+// wrapLogBlock lays out one rendered log block within width columns: the
+// first row follows prefix, and every later row -- a wrapped continuation
+// or a structured field row -- follows gutter, which should be as wide as
+// prefix. Rows wrap at word boundaries, breaking long words when needed.
+func wrapLogBlock(prefix, body, gutter string, width int) []string {
+	avail := max(width-ansi.StringWidth(prefix), 1)
+	var rows []string
+	for _, line := range strings.Split(body, "\n") {
+		for _, seg := range strings.Split(ansi.Wrap(line, avail, ""), "\n") {
+			lead := gutter
+			if len(rows) == 0 {
+				lead = prefix
+			}
+			rows = append(rows, lead+seg)
+		}
+	}
+	return rows
+}
+
+// logRun is an inclusive range of rawLines indices rendered as one line.
+type logRun struct {
+	start, end int
 }
 
-// colorizeLineWithHighlight renders a line with accent foreground for passive matches.
-func (m *Model) colorizeLineWithHighlight(line string, styles Styles) string {
-	return styles.AccentText.Render(line)
+// singleLogRuns returns one run per event: the uncollapsed view.
+func singleLogRuns(n int) []logRun {
+	runs := make([]logRun, n)
+	for i := range runs {
+		runs[i] = logRun{i, i}
+	}
+	return runs
+}
+
+// collapseLogRepeats groups consecutive events that differ only in
+// sequence and timestamp, so repeated progress ticks render once with a
+// count. rawLines itself is left intact for search.
+func collapseLogRepeats(events []spindle.LogEvent) []logRun {
+	var runs []logRun
+	for i, evt := range events {
+		if n := len(runs); n > 0 && sameLogLine(events[runs[n-1].end], evt) {
+			runs[n-1].end = i
+			continue
+		}
+		runs = append(runs, logRun{i, i})
+	}
+	return runs
+}
+
+// sameLogLine reports whether two events would render identically apart
+// from their timestamps.
+func sameLogLine(a, b spindle.LogEvent) bool {
+	return a.Level == b.Level && a.Message == b.Message && a.Component == b.Component &&
+		a.Stage == b.Stage && a.ItemID == b.ItemID && a.Lane == b.Lane && maps.Equal(a.Fields, b.Fields)
 }
 
 // styleLogEvent builds the styled log line directly from the structured
@@ -314,26 +457,32 @@ func (m *Model) colorizeLineWithHighlight(line string, styles Styles) string {
 // log view passes false; the problems view -- where error_hint is the most
 // direct answer to "what broke" -- passes true.
 func (m *Model) styleLogEvent(evt spindle.LogEvent, styles Styles, highlightErrorHint bool) string {
-	level := strings.ToUpper(strings.TrimSpace(evt.Level))
+	return m.styleLogEventMarked(evt, styles, highlightErrorHint, nil)
+}
+
+// styleLogEventMarked is styleLogEvent with the substrings matching search
+// marked in its style within each rendered segment; nil marks nothing.
+func (m *Model) styleLogEventMarked(evt spindle.LogEvent, styles Styles, highlightErrorHint bool, search *searchMark) string {
+	level := normalizeLogLevel(evt.Level)
 
 	var result strings.Builder
-	result.WriteString(styles.FaintText.Render(logEventTimestamp(evt)))
+	result.WriteString(m.markLogText(logEventTimestamp(evt), styles.FaintText, styles, search, false))
 	result.WriteString(" ")
-	result.WriteString(m.getLevelStyle(level, styles).Bold(true).Render(level))
+	result.WriteString(m.markLogText(level, m.getLevelStyle(level, styles).Bold(true), styles, search, false))
 
 	// Note: the [component] tag is part of formatLogEvent's plain text (for
 	// search) but is not shown here, since the stage is already surfaced via
 	// the subject below.
 	if subject := composeLogSubject(evt.ItemID, evt.Stage); subject != "" {
 		result.WriteString(" ")
-		result.WriteString(styles.AccentText.Render(subject))
+		result.WriteString(m.markLogText(subject, styles.AccentText, styles, search, true))
 	}
 
 	if message := strings.TrimSpace(evt.Message); message != "" {
 		result.WriteString(" ")
 		result.WriteString(styles.FaintText.Render("–"))
 		result.WriteString(" ")
-		result.WriteString(styles.Text.Render(message))
+		result.WriteString(m.markLogText(message, styles.Text, styles, search, true))
 	}
 
 	for _, key := range orderedFieldKeys(evt.Fields) {
@@ -342,7 +491,7 @@ func (m *Model) styleLogEvent(evt spindle.LogEvent, styles Styles, highlightErro
 			continue
 		}
 		result.WriteString("\n")
-		result.WriteString(styleLogFieldRow(key, value, styles, level, highlightErrorHint))
+		result.WriteString(m.styleLogFieldRow(key, value, styles, level, highlightErrorHint, search))
 	}
 
 	return result.String()
@@ -386,7 +535,8 @@ func orderedFieldKeys(fields map[string]string) []string {
 // out from plain diagnostic fields. When highlightErrorHint is set, the
 // error_hint field is rendered in the warning or danger style matching the
 // event's level, so it stands out as the direct answer to "what broke".
-func styleLogFieldRow(key, value string, styles Styles, level string, highlightErrorHint bool) string {
+// Substrings matching search are marked.
+func (m *Model) styleLogFieldRow(key, value string, styles Styles, level string, highlightErrorHint bool, search *searchMark) string {
 	labelStyle := styles.Text
 	valueStyle := styles.Text
 	if strings.HasPrefix(key, "decision_") {
@@ -397,13 +547,13 @@ func styleLogFieldRow(key, value string, styles Styles, level string, highlightE
 	}
 	if highlightErrorHint && key == "error_hint" {
 		hint := styles.WarningText
-		if level == "ERROR" {
+		if level == "ERROR" || level == "FATAL" {
 			hint = styles.DangerText
 		}
 		labelStyle = hint
 		valueStyle = hint.Bold(true)
 	}
-	return fmt.Sprintf("    - %s: %s", labelStyle.Render(key), valueStyle.Render(value))
+	return fmt.Sprintf("    - %s: %s", m.markLogText(key, labelStyle, styles, search, false), m.markLogText(value, valueStyle, styles, search, false))
 }
 
 // getLevelStyle returns the style for a log level.
@@ -415,8 +565,12 @@ func (m *Model) getLevelStyle(level string, styles Styles) lipgloss.Style {
 		return styles.WarningText
 	case "ERROR":
 		return styles.DangerText
+	case "FATAL":
+		return styles.DangerText.Underline(true)
 	case "DEBUG":
 		return styles.InfoText
+	case "TRACE":
+		return styles.MutedText
 	default:
 		return styles.Text
 	}
@@ -424,11 +578,14 @@ func (m *Model) getLevelStyle(level string, styles Styles) lipgloss.Style {
 
 // logFiltersActive returns true if any log filters are active.
 func (m *Model) logFiltersActive() bool {
-	return m.logState.filterLevel != "" || m.logState.filterComponent != "" || m.logState.filterLane != "" || m.logState.filterRequest != ""
+	return m.logState.filterLevel != "" || m.logState.filterComponent != "" || m.logState.filterLane != "" || m.logState.filterRequest != "" ||
+		!m.logState.filterFrom.at.IsZero() || !m.logState.filterTo.at.IsZero()
 }
 
 // handleLogsKey processes keyboard input for logs view.
 func (m Model) handleLogsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	m.logState.lastInput = m.clock()
+
 	// Handle search input mode
 	if m.logState.searchActive {
 		return m.handleLogSearchInput(msg)
@@ -440,6 +597,8 @@ func (m Model) handleLogsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		if m.logState.follow {
 			m.logViewport.GotoBottom()
 		}
+		m.prefs.LogFollowPaused = !m.logState.follow
+		m.savePrefs()
 		m.updateLogViewport()
 		return m, nil
 
@@ -453,6 +612,45 @@ func (m Model) handleLogsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		m.openLogFilters()
 		return m, nil
 
+	case key.Matches(msg, m.keys.CycleLogLevel):
+		m.logState.filterLevel = nextLogLevel(m.logState.filterLevel)
+		m.resetLogBuffer()
+		m.updateLogViewport()
+		return m, nil
+
+	case !m.inspecting && key.Matches(msg, m.keys.AllItemLogs):
+		m.toggleAllItemLogs()
+		m.updateLogViewport()
+		return m, nil
+
+	case !m.inspecting && m.logState.mode == logSourceAll && key.Matches(msg, m.keys.FocusLogItem):
+		m.logState.focusItem = nextFocusItem(m.logState.rawLines, m.logState.focusItem)
+		m.logState.contentVersion++
+		m.updateLogViewport()
+		return m, nil
+
+	case key.Matches(msg, m.keys.ToggleLogWrap):
+		m.prefs.LogWrap = !m.prefs.LogWrap
+		m.savePrefs()
+		m.logState.contentVersion++
+		m.updateLogViewport()
+		return m, nil
+
+	case key.Matches(msg, m.keys.ExportLogs):
+		m.errorMsg = m.exportLogs(".")
+		m.errorExpiry = time.Now().Add(5 * time.Second)
+		return m, nil
+
+	case key.Matches(msg, m.keys.SearchCase):
+		m.logState.searchCase = !m.logState.searchCase
+		m.rebuildLogSearch()
+		return m, nil
+
+	case key.Matches(msg, m.keys.SearchWord):
+		m.logState.searchWord = !m.logState.searchWord
+		m.rebuildLogSearch()
+		return m, nil
+
 	case key.Matches(msg, m.keys.NextMatch):
 		m.nextSearchMatch()
 		return m, nil
@@ -461,6 +659,22 @@ func (m Model) handleLogsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		m.previousSearchMatch()
 		return m, nil
 
+	case key.Matches(msg, m.keys.FirstMatch):
+		m.firstSearchMatch()
+		return m, nil
+
+	case key.Matches(msg, m.keys.NextProblem):
+		m.jumpProblemLine(true)
+		return m, nil
+
+	case key.Matches(msg, m.keys.PrevProblem):
+		m.jumpProblemLine(false)
+		return m, nil
+
+	case key.Matches(msg, m.keys.LastMatch):
+		m.lastSearchMatch()
+		return m, nil
+
 	case key.Matches(msg, m.keys.Escape):
 		// Clear search if active, otherwise return to the queue
 		if m.logState.searchRegex != nil {
@@ -517,6 +731,30 @@ func (m Model) handleLogsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// logsVisible reports whether a log surface (daemon view or inspector Logs
+// tab) is on screen.
+func (m Model) logsVisible() bool {
+	if m.inspecting {
+		return m.inspectorTab == tabLogs
+	}
+	return m.currentView == ViewLogs
+}
+
+// resumeIdleLogFollow re-enables follow once the operator has left a
+// paused log view alone for the configured idle period, so an unattended
+// screen returns to tailing. Disabled when the pref is zero.
+func (m *Model) resumeIdleLogFollow() {
+	idle := time.Duration(m.prefs.LogFollowIdleSeconds) * time.Second
+	if idle <= 0 || m.logState.follow || m.logState.searchActive || !m.logsVisible() {
+		return
+	}
+	if m.clock().Sub(m.logState.lastInput) < idle {
+		return
+	}
+	m.logState.follow = true
+	m.updateLogViewport()
+}
+
 // handleLogSearchInput handles keyboard input during log search.
 func (m *Model) handleLogSearchInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	switch {
@@ -529,7 +767,7 @@ func (m *Model) handleLogSearchInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
-		re, err := regexp.Compile("(?i)" + query)
+		re, err := buildSearchRegex(query, m.logState.searchCase, m.logState.searchWord)
 		if err != nil {
 			// Invalid regex - stay in search mode
 			return m, nil
@@ -544,6 +782,7 @@ func (m *Model) handleLogSearchInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		m.findSearchMatches()
 
 		// If matches found, scroll to first one
+		m.logState.searchWrapped = ""
 		if len(m.logState.searchMatches) > 0 {
 			m.logState.searchMatchIdx = 0
 			m.scrollToSearchMatch()
@@ -558,6 +797,16 @@ func (m *Model) handleLogSearchInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		m.logState.searchInput.Blur()
 		m.logState.searchInput.SetValue("")
 		return m, nil
+
+	case key.Matches(msg, m.keys.SearchCase):
+		m.logState.searchCase = !m.logState.searchCase
+		m.rebuildLogSearch()
+		return m, nil
+
+	case key.Matches(msg, m.keys.SearchWord):
+		m.logState.searchWord = !m.logState.searchWord
+		m.rebuildLogSearch()
+		return m, nil
 	}
 
 	// Let the text input handle the key
@@ -566,12 +815,79 @@ func (m *Model) handleLogSearchInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// buildSearchRegex compiles a log search query: case-insensitive unless
+// caseSensitive, and wrapped in \b word boundaries when wholeWord. The query
+// is validated on its own first so a bad pattern reports its own error.
+func buildSearchRegex(query string, caseSensitive, wholeWord bool) (*regexp.Regexp, error) {
+	if _, err := regexp.Compile(query); err != nil {
+		return nil, err
+	}
+	pattern := query
+	if wholeWord {
+		pattern = `\b(?:` + pattern + `)\b`
+	}
+	if !caseSensitive {
+		pattern = "(?i)" + pattern
+	}
+	return regexp.Compile(pattern)
+}
+
+// searchFlagsLabel describes the active search toggles, e.g. " [case word]",
+// empty when both are off.
+func (m *Model) searchFlagsLabel() string {
+	var flags []string
+	if m.logState.searchCase {
+		flags = append(flags, "case")
+	}
+	if m.logState.searchWord {
+		flags = append(flags, "word")
+	}
+	if len(flags) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(flags, " ") + "]"
+}
+
+// rebuildLogSearch recompiles the applied search after a toggle and re-runs
+// it, keeping the current match selected when it still matches, else the
+// next match after it.
+func (m *Model) rebuildLogSearch() {
+	if m.logState.searchQuery == "" {
+		return
+	}
+	re, err := buildSearchRegex(m.logState.searchQuery, m.logState.searchCase, m.logState.searchWord)
+	if err != nil {
+		return
+	}
+
+	current := -1
+	if idx := m.logState.searchMatchIdx; idx < len(m.logState.searchMatches) {
+		current = m.logState.searchMatches[idx]
+	}
+	m.logState.searchRegex = re
+	m.findSearchMatches()
+	m.logState.searchWrapped = ""
+
+	m.logState.searchMatchIdx = 0
+	for i, line := range m.logState.searchMatches {
+		if line >= current {
+			m.logState.searchMatchIdx = i
+			break
+		}
+	}
+	if len(m.logState.searchMatches) > 0 {
+		m.scrollToSearchMatch()
+	}
+	m.updateLogViewport()
+}
+
 // clearLogSearch clears the search state.
 func (m *Model) clearLogSearch() {
 	m.logState.searchRegex = nil
 	m.logState.searchQuery = ""
 	m.logState.searchMatches = nil
 	m.logState.searchMatchIdx = 0
+	m.logState.searchWrapped = ""
 	m.logState.contentVersion++ // Search highlighting changed
 }
 
@@ -590,42 +906,184 @@ func (m *Model) findSearchMatches() {
 	m.logState.contentVersion++ // Search highlighting changed
 }
 
-// nextSearchMatch moves to the next search match.
+// nextSearchMatch moves to the next search match, wrapping to the first.
 func (m *Model) nextSearchMatch() {
 	if len(m.logState.searchMatches) == 0 {
 		return
 	}
 
-	m.logState.searchMatchIdx = (m.logState.searchMatchIdx + 1) % len(m.logState.searchMatches)
-	m.logState.contentVersion++ // Active match changed
-	m.scrollToSearchMatch()
-	m.updateLogViewport()
+	old := m.logState.searchMatchIdx
+	m.gotoSearchMatch((old + 1) % len(m.logState.searchMatches))
+	m.logState.searchWrapped = searchWrap(old, m.logState.searchMatchIdx, true)
 }
 
-// previousSearchMatch moves to the previous search match.
+// previousSearchMatch moves to the previous search match, wrapping to the
+// last.
 func (m *Model) previousSearchMatch() {
 	if len(m.logState.searchMatches) == 0 {
 		return
 	}
 
-	m.logState.searchMatchIdx = (m.logState.searchMatchIdx - 1 + len(m.logState.searchMatches)) % len(m.logState.searchMatches)
+	old := m.logState.searchMatchIdx
+	m.gotoSearchMatch((old - 1 + len(m.logState.searchMatches)) % len(m.logState.searchMatches))
+	m.logState.searchWrapped = searchWrap(old, m.logState.searchMatchIdx, false)
+}
+
+// firstSearchMatch jumps to the first search match.
+func (m *Model) firstSearchMatch() {
+	if len(m.logState.searchMatches) > 0 {
+		m.gotoSearchMatch(0)
+	}
+}
+
+// lastSearchMatch jumps to the last search match.
+func (m *Model) lastSearchMatch() {
+	if n := len(m.logState.searchMatches); n > 0 {
+		m.gotoSearchMatch(n - 1)
+	}
+}
+
+// gotoSearchMatch selects match idx and scrolls it into view, clearing any
+// wrap indicator.
+func (m *Model) gotoSearchMatch(idx int) {
+	m.logState.searchMatchIdx = idx
+	m.logState.searchWrapped = ""
 	m.logState.contentVersion++ // Active match changed
 	m.scrollToSearchMatch()
 	m.updateLogViewport()
 }
 
+// searchWrap reports whether a step from match index old to next crossed
+// the end of the match list: "top" when stepping forward landed at or
+// before old, "bottom" when stepping back landed at or after it, else "".
+func searchWrap(old, next int, forward bool) string {
+	switch {
+	case forward && next <= old:
+		return "top"
+	case !forward && next >= old:
+		return "bottom"
+	default:
+		return ""
+	}
+}
+
+// logProblemLines returns the indices of the events at WARN or worse.
+func logProblemLines(events []spindle.LogEvent) []int {
+	var lines []int
+	for i, evt := range events {
+		switch normalizeLogLevel(evt.Level) {
+		case "WARN", "ERROR", "FATAL":
+			lines = append(lines, i)
+		}
+	}
+	return lines
+}
+
+// nextProblemLine returns the problem line after (forward) or before from,
+// wrapping at the ends; wrapped names the end it wrapped to ("top" or
+// "bottom"), else "". It returns -1 when there are no problem lines.
+func nextProblemLine(lines []int, from int, forward bool) (line int, wrapped string) {
+	if len(lines) == 0 {
+		return -1, ""
+	}
+	if forward {
+		for _, l := range lines {
+			if l > from {
+				return l, ""
+			}
+		}
+		return lines[0], "top"
+	}
+	for i := len(lines) - 1; i >= 0; i-- {
+		if lines[i] < from {
+			return lines[i], ""
+		}
+	}
+	return lines[len(lines)-1], "bottom"
+}
+
+// jumpProblemLine moves to the next or previous WARN/ERROR line,
+// independent of any text search. The first jump starts from the top of
+// the viewport, or from the end while following the tail.
+func (m *Model) jumpProblemLine(forward bool) {
+	m.updateLogViewport()
+	from := m.logState.problemLine
+	if from < 0 {
+		from = m.logTopLine() - 1
+		if !forward {
+			from = m.logTopLine()
+		}
+		if m.logState.follow {
+			from = len(m.logState.rawLines)
+		}
+	}
+	line, wrapped := nextProblemLine(m.logState.problemLines, from, forward)
+	if line < 0 {
+		m.errorMsg = "No warnings or errors in the log buffer"
+		m.errorExpiry = time.Now().Add(5 * time.Second)
+		return
+	}
+	m.logState.problemLine = line
+	m.logState.problemWrapped = wrapped
+	m.logState.contentVersion++ // Selected problem line changed
+	m.scrollToLogLine(line)
+}
+
+// logTopLine returns the rawLines index shown at the top of the viewport.
+func (m *Model) logTopLine() int {
+	top := 0
+	for i, row := range m.logState.rowOffsets {
+		if row > m.logViewport.YOffset() {
+			break
+		}
+		top = i
+	}
+	return top
+}
+
+// problemStatusLabel describes the selected problem line, e.g.
+// "problem 3/12 (wrapped to top)", or "" when none is selected.
+func (m *Model) problemStatusLabel() string {
+	idx := slices.Index(m.logState.problemLines, m.logState.problemLine)
+	if idx < 0 {
+		return ""
+	}
+	label := fmt.Sprintf("problem %d/%d", idx+1, len(m.logState.problemLines))
+	if m.logState.problemWrapped != "" {
+		label += " (wrapped to " + m.logState.problemWrapped + ")"
+	}
+	return label
+}
+
+// searchWrapLabel renders the wrap indicator for the search status.
+func (m *Model) searchWrapLabel(styles Styles) string {
+	if m.logState.searchWrapped == "" {
+		return ""
+	}
+	return styles.AccentText.Render(" (wrapped to " + m.logState.searchWrapped + ")")
+}
+
 // scrollToSearchMatch scrolls the viewport to show the current match.
 func (m *Model) scrollToSearchMatch() {
 	if len(m.logState.searchMatches) == 0 || m.logState.searchMatchIdx >= len(m.logState.searchMatches) {
 		return
 	}
 
-	targetLine := m.logState.searchMatches[m.logState.searchMatchIdx]
-	m.logState.follow = false
+	m.scrollToLogLine(m.logState.searchMatches[m.logState.searchMatchIdx])
+}
 
-	// Calculate scroll position to center the match if possible
+// scrollToLogLine centers rawLines index line in the viewport if possible,
+// pausing follow. It renders first so the row offsets reflect the current
+// buffer and wrap width.
+func (m *Model) scrollToLogLine(targetLine int) {
+	m.logState.follow = false
+	m.updateLogViewport()
+	targetRow := targetLine
+	if targetLine < len(m.logState.rowOffsets) {
+		targetRow = m.logState.rowOffsets[targetLine]
+	}
 	viewportHeight := m.logViewport.Height()
-	scrollTo := max(targetLine-viewportHeight/2, 0)
+	scrollTo := max(targetRow-viewportHeight/2, 0)
 	m.logViewport.SetYOffset(scrollTo)
 }
 
@@ -658,6 +1116,7 @@ func (m *Model) refreshLogs(item *spindle.QueueItem) tea.Cmd {
 
 // fetchDaemonLogs fetches daemon logs from the API.
 func (m *Model) fetchDaemonLogs() tea.Cmd {
+	mode := m.logState.mode
 	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(context.Background(), logFetchTimeout)
 		defer cancel()
@@ -668,8 +1127,10 @@ func (m *Model) fetchDaemonLogs() tea.Cmd {
 			Level:      m.logState.filterLevel,
 			Component:  m.logState.filterComponent,
 			Lane:       m.logState.filterLane,
-			DaemonOnly: true, // Only logs without item association
+			DaemonOnly: m.logState.mode != logSourceAll, // Daemon view: only logs without item association
 			Request:    m.logState.filterRequest,
+			From:       m.logState.filterFrom.at,
+			To:         m.logState.filterTo.at,
 		}
 		if m.logState.streamCursor == 0 {
 			query.Tail = true
@@ -683,7 +1144,7 @@ func (m *Model) fetchDaemonLogs() tea.Cmd {
 		return logBatchMsg{
 			events: batch.Events,
 			next:   batch.Next,
-			source: logSourceDaemon,
+			source: mode,
 		}
 	}
 }
@@ -720,6 +1181,8 @@ func (m *Model) fetchItemLogs(item *spindle.QueueItem) tea.Cmd {
 			Component: m.logState.filterComponent,
 			Lane:      m.logState.filterLane,
 			Request:   m.logState.filterRequest,
+			From:      m.logState.filterFrom.at,
+			To:        m.logState.filterTo.at,
 		}
 		if cursor == 0 {
 			query.Tail = true
@@ -768,30 +1231,84 @@ func (m *Model) handleLogBatch(msg logBatchMsg) {
 		m.logState.streamCursor = msg.next
 	}
 
-	// Guard against duplicate/overlapping batches: only append events whose
-	// Seq is strictly greater than the last one already appended. rawLines
-	// already tracks the active mode's events (cleared on item switch), so
-	// its last entry's Sequence doubles as the dedup cursor without needing
-	// a separate field.
+	if merged := mergeLogEvents(m.logState.rawLines, msg.events); len(merged) > len(m.logState.rawLines) {
+		m.logState.rawLines = trimLogBuffer(merged, logBufferLimit)
+		m.logState.contentVersion++ // Mark content changed
+		m.updateLogViewport()
+	}
+}
+
+// mergeLogEvents appends the incoming events to existing in Sequence
+// order. Guards against duplicate/overlapping batches: only events whose
+// Sequence is strictly greater than the last one already held are kept.
+// existing already tracks the active mode's events (cleared on mode or
+// item switch), so its last entry's Sequence doubles as the dedup cursor.
+// Incoming events are sorted first, so interleaved daemon and item lines
+// land in pipeline order even if a batch arrives out of order.
+func mergeLogEvents(existing, incoming []spindle.LogEvent) []spindle.LogEvent {
 	var lastSeq uint64
-	if n := len(m.logState.rawLines); n > 0 {
-		lastSeq = m.logState.rawLines[n-1].Sequence
+	if n := len(existing); n > 0 {
+		lastSeq = existing[n-1].Sequence
 	}
-	var newEvents []spindle.LogEvent
-	for _, evt := range msg.events {
+	sorted := append([]spindle.LogEvent(nil), incoming...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Sequence < sorted[j].Sequence
+	})
+	for _, evt := range sorted {
 		if evt.Sequence <= lastSeq {
 			continue
 		}
-		newEvents = append(newEvents, evt)
+		existing = append(existing, sanitizeLogEvent(evt))
 		lastSeq = evt.Sequence
 	}
+	return existing
+}
 
-	if len(newEvents) > 0 {
-		m.logState.rawLines = append(m.logState.rawLines, newEvents...)
-		m.logState.rawLines = trimLogBuffer(m.logState.rawLines, logBufferLimit)
-		m.logState.contentVersion++ // Mark content changed
-		m.updateLogViewport()
+// toggleAllItemLogs switches the log view between the daemon-only log and
+// the all-items log, refetching from the tail.
+func (m *Model) toggleAllItemLogs() {
+	if m.logState.mode == logSourceAll {
+		m.logState.mode = logSourceDaemon
+	} else {
+		m.logState.mode = logSourceAll
+	}
+	m.logState.focusItem = 0
+	m.resetLogBuffer()
+	m.logState.contentVersion++
+}
+
+// nextFocusItem cycles the all-items focus through the items present in
+// events in ascending ID order, then back to 0 (no focus).
+func nextFocusItem(events []spindle.LogEvent, current int64) int64 {
+	next := int64(0)
+	for _, evt := range events {
+		if evt.ItemID > current && (next == 0 || evt.ItemID < next) {
+			next = evt.ItemID
+		}
+	}
+	return next
+}
+
+// logLineFocused reports whether evt belongs to the focused item.
+func logLineFocused(evt spindle.LogEvent, focus int64) bool {
+	return focus > 0 && evt.ItemID == focus
+}
+
+// normalizeLogLevel maps an event's level field to the canonical uppercase
+// name styling keys on: case and surrounding brackets are ignored, and
+// common aliases (warning, err, critical, panic) fold into WARN, ERROR and
+// FATAL. Unknown levels are uppercased as-is.
+func normalizeLogLevel(level string) string {
+	level = strings.ToUpper(strings.Trim(strings.TrimSpace(level), "[]"))
+	switch level {
+	case "WARNING":
+		return "WARN"
+	case "ERR":
+		return "ERROR"
+	case "CRIT", "CRITICAL", "PANIC":
+		return "FATAL"
 	}
+	return level
 }
 
 // logEventTimestamp formats an event's timestamp for display, preferring the
@@ -803,10 +1320,67 @@ func logEventTimestamp(evt spindle.LogEvent) string {
 	return evt.Timestamp
 }
 
+// sanitizeLogEvent replaces invalid UTF-8 in an event's text with U+FFFD so
+// binary or truncated log output can't garble rendering and width math.
+// Valid events are returned untouched.
+func sanitizeLogEvent(evt spindle.LogEvent) spindle.LogEvent {
+	clean := func(s string) string {
+		if utf8.ValidString(s) {
+			return s
+		}
+		return strings.ToValidUTF8(s, "\uFFFD")
+	}
+	evt.Timestamp = clean(evt.Timestamp)
+	evt.Level = clean(evt.Level)
+	evt.Message = clean(evt.Message)
+	evt.Component = clean(evt.Component)
+	evt.Stage = clean(evt.Stage)
+	evt.Lane = clean(evt.Lane)
+
+	dirty := false
+	for k, v := range evt.Fields {
+		if !utf8.ValidString(k) || !utf8.ValidString(v) {
+			dirty = true
+			break
+		}
+	}
+	if dirty {
+		fields := make(map[string]string, len(evt.Fields))
+		for k, v := range evt.Fields {
+			fields[clean(k)] = clean(v)
+		}
+		evt.Fields = fields
+	}
+	return evt
+}
+
+// exportLogs writes the log buffer backing the current view (server-side
+// filters already applied) to dir as a plain-text file named for the
+// source and current time, returning a status message for the header.
+func (m Model) exportLogs(dir string) string {
+	name := "flyer-log-"
+	switch m.logState.mode {
+	case logSourceItem:
+		name = fmt.Sprintf("flyer-item-%d-log-", m.logState.lastItemID)
+	case logSourceAll:
+		name = "flyer-all-log-"
+	}
+	path := filepath.Join(dir, name+m.clock().Format("20060102-150405")+".log")
+
+	lines := make([]string, len(m.logState.rawLines))
+	for i, evt := range m.logState.rawLines {
+		lines[i] = formatLogEvent(evt)
+	}
+	if err := writeExportFile(path, func(w io.Writer) error { return export.WriteLines(w, lines) }); err != nil {
+		return "Export failed: " + err.Error()
+	}
+	return fmt.Sprintf("Exported %d log lines to %s", len(lines), path)
+}
+
 // formatLogEvent formats a single log event.
 func formatLogEvent(evt spindle.LogEvent) string {
 	ts := logEventTimestamp(evt)
-	level := strings.ToUpper(strings.TrimSpace(evt.Level))
+	level := normalizeLogLevel(evt.Level)
 	parts := []string{ts, level}
 	if component := strings.TrimSpace(evt.Component); component != "" {
 		parts = append(parts, fmt.Sprintf("[%s]", component))
@@ -884,10 +1458,23 @@ func (m *Model) initLogFilterInputs() {
 	reqInput.CharLimit = 50
 	reqInput.SetWidth(30)
 
+	// Time range inputs
+	fromInput := textinput.New()
+	fromInput.Placeholder = "e.g. 14:00 or -30m"
+	fromInput.CharLimit = 30
+	fromInput.SetWidth(30)
+
+	toInput := textinput.New()
+	toInput.Placeholder = "e.g. 14:30 (blank = now)"
+	toInput.CharLimit = 30
+	toInput.SetWidth(30)
+
 	m.logFilterInputs[0] = levelInput
 	m.logFilterInputs[1] = compInput
 	m.logFilterInputs[2] = laneInput
 	m.logFilterInputs[3] = reqInput
+	m.logFilterInputs[4] = fromInput
+	m.logFilterInputs[5] = toInput
 }
 
 // openLogFilters opens the log filters modal.
@@ -897,11 +1484,13 @@ func (m *Model) openLogFilters() {
 	m.logFilterInputs[1].SetValue(m.logState.filterComponent)
 	m.logFilterInputs[2].SetValue(m.logState.filterLane)
 	m.logFilterInputs[3].SetValue(m.logState.filterRequest)
+	m.logFilterInputs[4].SetValue(m.logState.filterFrom.input)
+	m.logFilterInputs[5].SetValue(m.logState.filterTo.input)
 	m.logFilterFocusIdx = 0
+	for i := range m.logFilterInputs {
+		m.logFilterInputs[i].Blur()
+	}
 	m.logFilterInputs[0].Focus()
-	m.logFilterInputs[1].Blur()
-	m.logFilterInputs[2].Blur()
-	m.logFilterInputs[3].Blur()
 	m.showLogFilters = true
 }
 
@@ -914,8 +1503,12 @@ func (m Model) handleLogFiltersKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case key.Matches(msg, m.keys.Confirm):
-		// Apply filters and close
-		m.applyLogFilters()
+		// Apply filters and close; a bad time keeps the modal open
+		if err := m.applyLogFilters(); err != nil {
+			m.errorMsg = err.Error()
+			m.errorExpiry = time.Now().Add(5 * time.Second)
+			return m, nil
+		}
 		m.showLogFilters = false
 		return m, nil
 
@@ -935,10 +1528,9 @@ func (m Model) handleLogFiltersKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 
 	case msg.String() == "ctrl+c":
 		// Clear all filters (modal-specific, doesn't quit)
-		m.logFilterInputs[0].SetValue("")
-		m.logFilterInputs[1].SetValue("")
-		m.logFilterInputs[2].SetValue("")
-		m.logFilterInputs[3].SetValue("")
+		for i := range m.logFilterInputs {
+			m.logFilterInputs[i].SetValue("")
+		}
 		return m, nil
 	}
 
@@ -948,20 +1540,120 @@ func (m Model) handleLogFiltersKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
-// applyLogFilters applies the filter values from the modal.
-func (m *Model) applyLogFilters() {
+// applyLogFilters applies the filter values from the modal. Relative
+// times resolve against the current clock. An unparseable time leaves
+// every filter unchanged.
+func (m *Model) applyLogFilters() error {
+	now := m.clock()
+	from, err := newLogTimeFilter(m.logFilterInputs[4].Value(), now)
+	if err != nil {
+		return fmt.Errorf("From: %w", err)
+	}
+	to, err := newLogTimeFilter(m.logFilterInputs[5].Value(), now)
+	if err != nil {
+		return fmt.Errorf("To: %w", err)
+	}
+
 	m.logState.filterLevel = strings.TrimSpace(m.logFilterInputs[0].Value())
 	m.logState.filterComponent = strings.TrimSpace(m.logFilterInputs[1].Value())
 	m.logState.filterLane = strings.TrimSpace(m.logFilterInputs[2].Value())
 	m.logState.filterRequest = strings.TrimSpace(m.logFilterInputs[3].Value())
+	m.logState.filterFrom = from
+	m.logState.filterTo = to
+	m.resetLogBuffer()
+	return nil
+}
+
+// logTimeFilter is a log time bound as typed in the filters modal and the
+// instant it resolved to when applied (zero when blank).
+type logTimeFilter struct {
+	input string
+	at    time.Time
+}
+
+// newLogTimeFilter parses input into a time bound relative to now.
+func newLogTimeFilter(input string, now time.Time) (logTimeFilter, error) {
+	input = strings.TrimSpace(input)
+	at, err := parseLogTime(input, now)
+	if err != nil {
+		return logTimeFilter{}, err
+	}
+	return logTimeFilter{input: input, at: at}, nil
+}
+
+// parseLogTime parses a log time filter: blank (no bound), a negative
+// offset from now ("-30m", "-2h"), a clock time ("14:00", "14:00:30") on
+// the most recent day it has passed, or an RFC3339 timestamp.
+func parseLogTime(input string, now time.Time) (time.Time, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return time.Time{}, nil
+	}
+	if strings.HasPrefix(input, "-") {
+		d, err := time.ParseDuration(input)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid offset %q", input)
+		}
+		return now.Add(d), nil
+	}
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		clock, err := time.ParseInLocation(layout, input, now.Location())
+		if err != nil {
+			continue
+		}
+		y, mo, d := now.Date()
+		at := time.Date(y, mo, d, clock.Hour(), clock.Minute(), clock.Second(), 0, now.Location())
+		if at.After(now) {
+			at = at.AddDate(0, 0, -1)
+		}
+		return at, nil
+	}
+	if at, err := time.Parse(time.RFC3339, input); err == nil {
+		return at, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (use HH:MM, -30m, or RFC3339)", input)
+}
+
+// logTimeRangeLabel renders a time range filter for the status bar, e.g.
+// "time 14:00–14:30"; an open end shows as "…". Empty when unbounded.
+func logTimeRangeLabel(from, to time.Time) string {
+	if from.IsZero() && to.IsZero() {
+		return ""
+	}
+	clock := func(t time.Time) string {
+		if t.IsZero() {
+			return "…"
+		}
+		return t.Format("15:04")
+	}
+	return "time " + clock(from) + "–" + clock(to)
+}
 
-	// Reset log buffer to fetch with new filters
+// resetLogBuffer drops the buffered log lines and cursors so the next
+// refresh refetches with the current filters.
+func (m *Model) resetLogBuffer() {
 	m.logState.rawLines = nil
+	m.logState.problemLine = -1
 	m.logState.streamCursor = 0
 	m.logState.itemCursor = 0
 	m.clearLogSearch()
 }
 
+// logLevelCycle is the quick level filter order; "" shows every level.
+var logLevelCycle = []string{"", "error", "warn", "info", "debug"}
+
+// nextLogLevel returns the level filter after current in logLevelCycle. A
+// level outside the cycle (typed in the filters modal) moves to the first
+// real level.
+func nextLogLevel(current string) string {
+	for i, level := range logLevelCycle {
+		if strings.EqualFold(strings.TrimSpace(current), level) {
+			return logLevelCycle[(i+1)%len(logLevelCycle)]
+		}
+	}
+	return logLevelCycle[1]
+}
+
 // renderLogFilters renders the log filters modal.
 func (m Model) renderLogFilters() string {
 	styles := m.theme.Styles()
@@ -990,6 +1682,8 @@ func (m Model) renderLogFilters() string {
 		{"Component: ", 1},
 		{"Lane:      ", 2},
 		{"Request:   ", 3},
+		{"From:      ", 4},
+		{"To:        ", 5},
 	}
 	for _, f := range fields {
 		label := f.label
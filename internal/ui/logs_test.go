@@ -1,12 +1,21 @@
 package ui
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/five82/flyer/internal/prefs"
 	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/spindle/spindletest"
 )
 
 // stripANSI is defined in problems_test.go and reused here to check styled
@@ -223,3 +232,726 @@ func TestHandleLogBatchDedupesOverlappingSeq(t *testing.T) {
 		t.Fatalf("last appended seq = %d, want 4", last.Sequence)
 	}
 }
+
+// TestResumeIdleLogFollow verifies that a paused log view returns to
+// tailing only after the configured idle period, using an injected clock.
+func TestResumeIdleLogFollow(t *testing.T) {
+	start := time.Date(2026, 7, 5, 12, 0, 0, 0, time.UTC)
+	now := start
+	m := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{LogFollowIdleSeconds: 30}})
+	m.now = func() time.Time { return now }
+	m.initLogState()
+	m.currentView = ViewLogs
+	m.logState.follow = false
+	m.logState.lastInput = start
+
+	now = start.Add(29 * time.Second)
+	m.resumeIdleLogFollow()
+	if m.logState.follow {
+		t.Fatal("follow resumed before the idle period elapsed")
+	}
+
+	now = start.Add(30 * time.Second)
+	m.resumeIdleLogFollow()
+	if !m.logState.follow {
+		t.Fatal("follow not resumed after the idle period elapsed")
+	}
+}
+
+func TestResumeIdleLogFollow_DisabledOrNotVisible(t *testing.T) {
+	start := time.Date(2026, 7, 5, 12, 0, 0, 0, time.UTC)
+	later := func() time.Time { return start.Add(time.Hour) }
+
+	m := New(Options{ThemeName: "slate"})
+	m.now = later
+	m.initLogState()
+	m.currentView = ViewLogs
+	m.logState.follow = false
+	m.logState.lastInput = start
+	m.resumeIdleLogFollow()
+	if m.logState.follow {
+		t.Fatal("follow resumed with the pref disabled")
+	}
+
+	m = New(Options{ThemeName: "slate", Prefs: prefs.Prefs{LogFollowIdleSeconds: 30}})
+	m.now = later
+	m.initLogState()
+	m.currentView = ViewQueue
+	m.logState.follow = false
+	m.logState.lastInput = start
+	m.resumeIdleLogFollow()
+	if m.logState.follow {
+		t.Fatal("follow resumed while no log surface is visible")
+	}
+}
+
+// TestResumeIdleLogFollow_RestoredPause verifies that a pause restored
+// from prefs, and one carried into a later visit to the log view, holds for
+// a full idle period from the moment the view is entered.
+func TestResumeIdleLogFollow_RestoredPause(t *testing.T) {
+	start := time.Date(2026, 7, 5, 12, 0, 0, 0, time.UTC)
+	now := start
+	m := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{LogFollowPaused: true, LogFollowIdleSeconds: 30}})
+	m.now = func() time.Time { return now }
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = updated.(Model)
+
+	updated, _ = m.openDaemonLogs()
+	m = updated.(Model)
+	m.resumeIdleLogFollow()
+	if m.logState.follow {
+		t.Fatal("restored pause undone on the first tick")
+	}
+
+	m.currentView = ViewQueue
+	now = start.Add(time.Hour)
+	updated, _ = m.openDaemonLogs()
+	m = updated.(Model)
+	m.resumeIdleLogFollow()
+	if m.logState.follow {
+		t.Fatal("pause undone on re-entering the log view after time elsewhere")
+	}
+
+	now = now.Add(30 * time.Second)
+	m.resumeIdleLogFollow()
+	if !m.logState.follow {
+		t.Fatal("follow not resumed after the idle period elapsed")
+	}
+}
+
+// TestHandleLogsKeyRecordsInput verifies that log-view keypresses restart
+// the idle clock.
+func TestHandleLogsKeyRecordsInput(t *testing.T) {
+	now := time.Date(2026, 7, 5, 12, 0, 0, 0, time.UTC)
+	m := New(Options{ThemeName: "slate"})
+	m.now = func() time.Time { return now }
+	m.initLogState()
+	m.currentView = ViewLogs
+
+	updated, _ := m.handleLogsKey(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	got := updated.(Model)
+	if !got.logState.lastInput.Equal(now) {
+		t.Fatalf("lastInput = %v, want %v", got.logState.lastInput, now)
+	}
+	if got.logState.follow {
+		t.Fatal("scrolling up should pause follow")
+	}
+}
+
+// TestHandleLogBatchSanitizesInvalidUTF8 verifies that binary or truncated
+// log text is stored with U+FFFD replacements and renders as valid UTF-8.
+func TestHandleLogBatchSanitizesInvalidUTF8(t *testing.T) {
+	m := &Model{theme: GetTheme("Nightfox")}
+	fields := map[string]string{"path": "/media/\xc3"}
+	m.handleLogBatch(logBatchMsg{
+		source: logSourceDaemon,
+		next:   1,
+		events: []spindle.LogEvent{{
+			Sequence: 1,
+			Level:    "info",
+			Message:  "ripped \xff\xfe title caf\xc3\xa9 \xe2\x82",
+			Fields:   fields,
+		}},
+	})
+
+	evt := m.logState.rawLines[0]
+	if want := "ripped \uFFFD title caf\u00e9 \uFFFD"; evt.Message != want {
+		t.Fatalf("Message = %q, want %q", evt.Message, want)
+	}
+	if fields["path"] != "/media/\xc3" {
+		t.Fatalf("sanitizing mutated the caller's Fields map")
+	}
+	plain := formatLogEvent(evt)
+	styled := m.styleLogEvent(evt, m.theme.Styles(), false)
+	for name, out := range map[string]string{"formatLogEvent": plain, "styleLogEvent": styled} {
+		if !utf8.ValidString(out) {
+			t.Fatalf("%s output is not valid UTF-8: %q", name, out)
+		}
+		if !strings.Contains(stripANSI(out), "/media/\uFFFD") {
+			t.Fatalf("%s output lost the sanitized field: %q", name, out)
+		}
+	}
+}
+
+func TestCollapseLogRepeats(t *testing.T) {
+	tick := func(seq uint64, msg string) spindle.LogEvent {
+		return spindle.LogEvent{Sequence: seq, Timestamp: fmt.Sprintf("2026-01-02T10:00:%02dZ", seq), Level: "info", Message: msg}
+	}
+	withField := tick(6, "progress")
+	withField.Fields = map[string]string{"percent": "50"}
+	events := []spindle.LogEvent{
+		tick(1, "start"),
+		tick(2, "progress"), tick(3, "progress"), tick(4, "progress"),
+		tick(5, "start"),
+		withField,
+		tick(7, "progress"),
+	}
+
+	got := collapseLogRepeats(events)
+	want := []logRun{{0, 0}, {1, 3}, {4, 4}, {5, 5}, {6, 6}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("collapseLogRepeats() = %v, want %v", got, want)
+	}
+	if collapseLogRepeats(nil) != nil {
+		t.Fatalf("collapseLogRepeats(nil) should be nil")
+	}
+}
+
+func TestRenderLogContent_CollapsesRepeatsWhenEnabled(t *testing.T) {
+	events := []spindle.LogEvent{
+		{Sequence: 1, Level: "info", Message: "ripping title 2"},
+		{Sequence: 2, Level: "info", Message: "ripping title 2"},
+		{Sequence: 3, Level: "info", Message: "ripping title 2"},
+		{Sequence: 4, Level: "warn", Message: "disc slow"},
+	}
+	m := New(Options{ThemeName: "slate"})
+	m.logState.rawLines = events
+
+	plain := stripANSI(m.renderLogContent())
+	if n := strings.Count(plain, "ripping title 2"); n != 3 {
+		t.Fatalf("uncollapsed view shows %d repeats, want 3:\n%s", n, plain)
+	}
+
+	m.prefs.LogCollapseRepeats = true
+	m.logState.searchRegex = regexp.MustCompile("ripping")
+	m.findSearchMatches()
+	collapsed := stripANSI(m.renderLogContent())
+	lines := strings.Split(collapsed, "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], "ripping title 2 (×3)") || !strings.Contains(lines[0], "   3 │") {
+		t.Fatalf("collapsed view = %q, want one counted line numbered after the newest repeat", lines)
+	}
+	if len(m.logState.searchMatches) != 3 || len(m.logState.rawLines) != 4 {
+		t.Fatalf("search matched %d of %d raw lines, want all 3 repeats kept", len(m.logState.searchMatches), len(m.logState.rawLines))
+	}
+}
+
+func TestNormalizeLogLevel(t *testing.T) {
+	cases := map[string]string{
+		"warn":      "WARN",
+		" Warning ": "WARN",
+		"[error]":   "ERROR",
+		"err":       "ERROR",
+		"trace":     "TRACE",
+		"FATAL":     "FATAL",
+		"critical":  "FATAL",
+		"notice":    "NOTICE",
+		"":          "",
+	}
+	for in, want := range cases {
+		if got := normalizeLogLevel(in); got != want {
+			t.Errorf("normalizeLogLevel(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestStyleLogEvent_LevelComesFromLevelField(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	styles := m.theme.Styles()
+
+	prose := spindle.LogEvent{Level: "info", Message: "retrying after error in the drive"}
+	if got := stripANSI(m.styleLogEvent(prose, styles, false)); !strings.HasPrefix(got, " INFO ") {
+		t.Fatalf("prose mentioning error should stay INFO, got %q", got)
+	}
+	for _, level := range []string{"trace", "fatal", "[warn]"} {
+		got := m.styleLogEvent(spindle.LogEvent{Level: level, Message: "x"}, styles, false)
+		want := m.getLevelStyle(normalizeLogLevel(level), styles).Bold(true).Render(normalizeLogLevel(level))
+		if !strings.Contains(got, want) {
+			t.Errorf("level %q rendered %q, want the %s style", level, got, normalizeLogLevel(level))
+		}
+	}
+	if m.getLevelStyle("FATAL", styles).GetForeground() != styles.DangerText.GetForeground() {
+		t.Errorf("FATAL should use the danger color")
+	}
+}
+
+// TestRefreshLogs_FakeClient drives daemon log refreshes through a scripted
+// client: a batch lands in the buffer, and a failed fetch surfaces the log
+// error without dropping what was already fetched.
+func TestRefreshLogs_FakeClient(t *testing.T) {
+	fake := (&spindletest.FakeClient{}).
+		ScriptLogs(spindle.LogBatch{Events: []spindle.LogEvent{{Sequence: 1}, {Sequence: 2}}, Next: 2}, nil).
+		ScriptLogs(spindle.LogBatch{}, fmt.Errorf("connection refused"))
+	m := New(Options{ThemeName: "slate", Client: fake})
+
+	cmd := m.refreshLogs(nil)
+	if cmd == nil {
+		t.Fatal("refreshLogs() = nil, want a fetch")
+	}
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+	if got := len(m.logState.rawLines); got != 2 {
+		t.Fatalf("rawLines len = %d, want 2", got)
+	}
+	if m.logState.streamCursor != 2 {
+		t.Fatalf("streamCursor = %d, want 2", m.logState.streamCursor)
+	}
+
+	m.logState.lastRefresh = time.Time{}
+	updated, _ = m.Update(m.refreshLogs(nil)())
+	m = updated.(Model)
+	if m.errorMsg != "Log fetch failed" {
+		t.Fatalf("errorMsg = %q, want log fetch failure", m.errorMsg)
+	}
+	if got := len(m.logState.rawLines); got != 2 {
+		t.Fatalf("rawLines len after failure = %d, want 2 kept", got)
+	}
+
+	queries := fake.LogQueries()
+	if len(queries) != 2 || !queries[0].Tail || !queries[0].DaemonOnly || queries[1].Tail || queries[1].Since != 2 {
+		t.Fatalf("log queries = %+v, want an initial tail then a fetch since 2", queries)
+	}
+}
+
+func TestExportLogs_WritesPlainBuffer(t *testing.T) {
+	dir := t.TempDir()
+	m := New(Options{ThemeName: "slate"})
+	m.now = func() time.Time { return time.Date(2026, 5, 1, 15, 4, 5, 0, time.UTC) }
+	m.logState.mode = logSourceItem
+	m.logState.lastItemID = 42
+	m.logState.rawLines = []spindle.LogEvent{
+		{Timestamp: "2026-05-01T15:00:00Z", Level: "error", Component: "encoder", ItemID: 42, Message: "ffmpeg exited 1"},
+		{Timestamp: "2026-05-01T15:00:01Z", Level: "info", Message: "retrying"},
+	}
+
+	path := filepath.Join(dir, "flyer-item-42-log-20260501-150405.log")
+	if got, want := m.exportLogs(dir), "Exported 2 log lines to "+path; got != want {
+		t.Fatalf("exportLogs() = %q, want %q", got, want)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read log export: %v", err)
+	}
+	want := formatLogEvent(m.logState.rawLines[0]) + "\n" + formatLogEvent(m.logState.rawLines[1]) + "\n"
+	if string(data) != want {
+		t.Fatalf("log export = %q, want %q", data, want)
+	}
+	if strings.Contains(string(data), "\x1b") {
+		t.Fatalf("log export contains escape codes: %q", data)
+	}
+}
+
+func TestBuildSearchRegex_Toggles(t *testing.T) {
+	tests := []struct {
+		caseSensitive, wholeWord bool
+		matches                  map[string]bool
+	}{
+		{false, false, map[string]bool{"disc error": true, "Disc Error": true, "errors": true}},
+		{true, false, map[string]bool{"disc error": true, "Disc Error": false, "errors": true}},
+		{false, true, map[string]bool{"disc error": true, "Disc Error": true, "errors": false}},
+		{true, true, map[string]bool{"disc error": true, "Disc Error": false, "errors": false}},
+	}
+	for _, tt := range tests {
+		re, err := buildSearchRegex("error", tt.caseSensitive, tt.wholeWord)
+		if err != nil {
+			t.Fatalf("buildSearchRegex(case=%v, word=%v): %v", tt.caseSensitive, tt.wholeWord, err)
+		}
+		for line, want := range tt.matches {
+			if got := re.MatchString(line); got != want {
+				t.Errorf("case=%v word=%v: match %q = %v, want %v", tt.caseSensitive, tt.wholeWord, line, got, want)
+			}
+		}
+	}
+
+	// Alternation stays grouped inside the word boundaries.
+	re, err := buildSearchRegex("rip|encode", false, true)
+	if err != nil {
+		t.Fatalf("buildSearchRegex(alternation): %v", err)
+	}
+	if re.MatchString("ripping") || !re.MatchString("encode done") {
+		t.Fatalf("whole-word alternation = %q, want both branches bounded", re.String())
+	}
+}
+
+func TestBuildSearchRegex_InvalidPattern(t *testing.T) {
+	for _, word := range []bool{false, true} {
+		if _, err := buildSearchRegex("disc(", false, word); err == nil {
+			t.Fatalf("buildSearchRegex(%q, word=%v) error = nil, want error", "disc(", word)
+		}
+	}
+}
+
+func TestRebuildLogSearch_KeepsCurrentMatch(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.logState.rawLines = []spindle.LogEvent{
+		{Message: "Error one"},
+		{Message: "error two"},
+		{Message: "errors three"},
+		{Message: "error four"},
+	}
+	m.logState.searchQuery = "error"
+	m.logState.searchRegex, _ = buildSearchRegex("error", false, false)
+	m.findSearchMatches()
+	m.logState.searchMatchIdx = 1 // line 1
+
+	m.logState.searchCase = true
+	m.rebuildLogSearch()
+	if got, want := m.logState.searchMatches, []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Fatalf("case-sensitive matches = %v, want %v", got, want)
+	}
+	if m.logState.searchMatchIdx != 0 {
+		t.Fatalf("searchMatchIdx = %d, want 0 (line 1 kept)", m.logState.searchMatchIdx)
+	}
+
+	m.logState.searchMatchIdx = 1 // line 2, which whole-word drops
+	m.logState.searchWord = true
+	m.rebuildLogSearch()
+	if got, want := m.logState.searchMatches, []int{1, 3}; !slices.Equal(got, want) {
+		t.Fatalf("whole-word matches = %v, want %v", got, want)
+	}
+	if m.logState.searchMatchIdx != 1 {
+		t.Fatalf("searchMatchIdx = %d, want 1 (next match, line 3)", m.logState.searchMatchIdx)
+	}
+}
+
+func TestSearchWrap(t *testing.T) {
+	tests := []struct {
+		old, next int
+		forward   bool
+		want      string
+	}{
+		{0, 1, true, ""},
+		{3, 0, true, "top"},
+		{0, 0, true, "top"}, // a single match always wraps
+		{2, 1, false, ""},
+		{0, 3, false, "bottom"},
+		{0, 0, false, "bottom"},
+	}
+	for _, tt := range tests {
+		if got := searchWrap(tt.old, tt.next, tt.forward); got != tt.want {
+			t.Errorf("searchWrap(%d, %d, %v) = %q, want %q", tt.old, tt.next, tt.forward, got, tt.want)
+		}
+	}
+}
+
+func TestSearchMatchNavigation_WrapAndJumps(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.logState.searchMatches = []int{2, 5, 9}
+
+	m.lastSearchMatch()
+	if m.logState.searchMatchIdx != 2 {
+		t.Fatalf("lastSearchMatch() idx = %d, want 2", m.logState.searchMatchIdx)
+	}
+	m.nextSearchMatch()
+	if m.logState.searchMatchIdx != 0 || m.logState.searchWrapped != "top" {
+		t.Fatalf("next from last: idx=%d wrapped=%q, want 0 top", m.logState.searchMatchIdx, m.logState.searchWrapped)
+	}
+	m.nextSearchMatch()
+	if m.logState.searchWrapped != "" {
+		t.Fatalf("wrap indicator = %q after a plain step, want cleared", m.logState.searchWrapped)
+	}
+
+	m.firstSearchMatch()
+	if m.logState.searchMatchIdx != 0 {
+		t.Fatalf("firstSearchMatch() idx = %d, want 0", m.logState.searchMatchIdx)
+	}
+	m.previousSearchMatch()
+	if m.logState.searchMatchIdx != 2 || m.logState.searchWrapped != "bottom" {
+		t.Fatalf("prev from first: idx=%d wrapped=%q, want 2 bottom", m.logState.searchMatchIdx, m.logState.searchWrapped)
+	}
+}
+
+func TestNextLogLevel_Cycles(t *testing.T) {
+	tests := map[string]string{
+		"":      "error",
+		"error": "warn",
+		"WARN":  "info",
+		"info":  "debug",
+		"debug": "",
+		"fatal": "error", // typed in the modal, outside the cycle
+	}
+	for current, want := range tests {
+		if got := nextLogLevel(current); got != want {
+			t.Errorf("nextLogLevel(%q) = %q, want %q", current, got, want)
+		}
+	}
+}
+
+func TestCycleLogLevel_ResetsBufferAndKeepsOtherFilters(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.currentView = ViewLogs
+	m.logState.filterLevel = "info"
+	m.logState.filterComponent = "encoder"
+	m.logState.filterLane = "background"
+	m.logState.rawLines = []spindle.LogEvent{{Message: "old"}}
+	m.logState.streamCursor = 40
+	m.logState.itemCursor = 12
+
+	updated, _ := m.handleLogsKey(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	got := updated.(Model)
+	if got.logState.filterLevel != "debug" {
+		t.Fatalf("filterLevel = %q, want debug", got.logState.filterLevel)
+	}
+	if got.logState.rawLines != nil || got.logState.streamCursor != 0 || got.logState.itemCursor != 0 {
+		t.Fatalf("buffer not reset: lines=%d stream=%d item=%d", len(got.logState.rawLines), got.logState.streamCursor, got.logState.itemCursor)
+	}
+	if got.logState.filterComponent != "encoder" || got.logState.filterLane != "background" {
+		t.Fatalf("other filters changed: component=%q lane=%q", got.logState.filterComponent, got.logState.filterLane)
+	}
+	if status := stripANSI(got.renderLogStatus(got.theme.Styles())); !strings.Contains(status, "level DEBUG") {
+		t.Fatalf("status = %q, want the quick level", status)
+	}
+}
+
+func TestParseLogTime(t *testing.T) {
+	now := time.Date(2026, 5, 1, 14, 45, 0, 0, time.UTC)
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"", time.Time{}},
+		{"-30m", time.Date(2026, 5, 1, 14, 15, 0, 0, time.UTC)},
+		{" -1h30m ", time.Date(2026, 5, 1, 13, 15, 0, 0, time.UTC)},
+		{"14:00", time.Date(2026, 5, 1, 14, 0, 0, 0, time.UTC)},
+		{"09:05:30", time.Date(2026, 5, 1, 9, 5, 30, 0, time.UTC)},
+		{"23:00", time.Date(2026, 4, 30, 23, 0, 0, 0, time.UTC)}, // not yet today: yesterday
+		{"2026-04-30T08:00:00Z", time.Date(2026, 4, 30, 8, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseLogTime(tt.input, now)
+		if err != nil {
+			t.Fatalf("parseLogTime(%q): %v", tt.input, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("parseLogTime(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"-abc", "25:00", "yesterday", "14h"} {
+		if _, err := parseLogTime(bad, now); err == nil {
+			t.Errorf("parseLogTime(%q) error = nil, want error", bad)
+		}
+	}
+}
+
+func TestApplyLogFilters_TimeRange(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.now = func() time.Time { return time.Date(2026, 5, 1, 14, 45, 0, 0, time.UTC) }
+	m.initLogState()
+	m.initLogFilterInputs()
+	m.currentView = ViewLogs
+	m.logState.rawLines = []spindle.LogEvent{{Message: "old"}}
+
+	m.openLogFilters()
+	m.logFilterInputs[4].SetValue("14:00")
+	m.logFilterInputs[5].SetValue("-15m")
+	if err := m.applyLogFilters(); err != nil {
+		t.Fatalf("applyLogFilters: %v", err)
+	}
+	if m.logState.rawLines != nil {
+		t.Fatalf("buffer not reset after applying a time range")
+	}
+	if status := stripANSI(m.renderLogStatus(m.theme.Styles())); !strings.Contains(status, "time 14:00–14:30") {
+		t.Fatalf("status = %q, want the time range", status)
+	}
+
+	m.logFilterInputs[4].SetValue("noon")
+	if err := m.applyLogFilters(); err == nil {
+		t.Fatalf("applyLogFilters(bad from) error = nil, want error")
+	}
+	if m.logState.filterFrom.input != "14:00" {
+		t.Fatalf("filterFrom = %q after a bad time, want it unchanged", m.logState.filterFrom.input)
+	}
+}
+
+func TestMergeLogEvents_InterleavesBySequence(t *testing.T) {
+	existing := []spindle.LogEvent{{Sequence: 1, Message: "daemon start"}}
+	incoming := []spindle.LogEvent{
+		{Sequence: 4, ItemID: 8, Message: "encode"},
+		{Sequence: 2, ItemID: 7, Message: "rip"},
+		{Sequence: 1, Message: "duplicate"},
+		{Sequence: 3, Message: "daemon tick"},
+	}
+
+	got := mergeLogEvents(existing, incoming)
+	var seqs []uint64
+	for _, evt := range got {
+		seqs = append(seqs, evt.Sequence)
+	}
+	if want := []uint64{1, 2, 3, 4}; !slices.Equal(seqs, want) {
+		t.Fatalf("sequences = %v, want %v", seqs, want)
+	}
+	if got[0].Message != "daemon start" {
+		t.Fatalf("existing event replaced: %q", got[0].Message)
+	}
+}
+
+func TestAllItemLogs_FetchesEveryItemAndFocusesOne(t *testing.T) {
+	fake := (&spindletest.FakeClient{}).ScriptLogs(spindle.LogBatch{
+		Events: []spindle.LogEvent{
+			{Sequence: 1, Message: "daemon"},
+			{Sequence: 2, ItemID: 9, Stage: "encoding", Message: "encode"},
+			{Sequence: 3, ItemID: 4, Stage: "ripping", Message: "rip"},
+		},
+		Next: 3,
+	}, nil)
+	m := New(Options{ThemeName: "slate", Client: fake})
+	m.initLogState()
+	m.currentView = ViewLogs
+	m.logState.rawLines = []spindle.LogEvent{{Sequence: 1, Message: "old"}}
+	m.logState.streamCursor = 1
+
+	updated, _ := m.handleLogsKey(tea.KeyPressMsg{Code: 'm', Text: "m"})
+	m = updated.(Model)
+	if m.logState.mode != logSourceAll || m.logState.rawLines != nil || m.logState.streamCursor != 0 {
+		t.Fatalf("mode=%d lines=%d cursor=%d, want all-items mode with a reset buffer", m.logState.mode, len(m.logState.rawLines), m.logState.streamCursor)
+	}
+
+	updated, _ = m.Update(m.refreshLogs(nil)())
+	m = updated.(Model)
+	if queries := fake.LogQueries(); len(queries) != 1 || queries[0].DaemonOnly {
+		t.Fatalf("log queries = %+v, want one fetch without DaemonOnly", queries)
+	}
+	if got := len(m.logState.rawLines); got != 3 {
+		t.Fatalf("rawLines len = %d, want 3", got)
+	}
+
+	// o cycles the focus through item IDs in ascending order, then off.
+	for _, want := range []int64{4, 9, 0} {
+		updated, _ = m.handleLogsKey(tea.KeyPressMsg{Code: 'o', Text: "o"})
+		m = updated.(Model)
+		if m.logState.focusItem != want {
+			t.Fatalf("focusItem = %d, want %d", m.logState.focusItem, want)
+		}
+	}
+
+	m.logState.focusItem = 9
+	if !logLineFocused(m.logState.rawLines[1], 9) || logLineFocused(m.logState.rawLines[0], 9) || logLineFocused(m.logState.rawLines[2], 9) {
+		t.Fatal("logLineFocused tagged the wrong lines")
+	}
+	if status := stripANSI(m.renderLogStatus(m.theme.Styles())); !strings.Contains(status, "All log") || !strings.Contains(status, "focus #9") {
+		t.Fatalf("status = %q, want the all-items source and focus", status)
+	}
+
+	updated, _ = m.handleLogsKey(tea.KeyPressMsg{Code: 'm', Text: "m"})
+	m = updated.(Model)
+	if m.logState.mode != logSourceDaemon || m.logState.focusItem != 0 {
+		t.Fatalf("mode=%d focus=%d, want daemon mode without focus", m.logState.mode, m.logState.focusItem)
+	}
+}
+
+func TestWrapLogBlock_ContinuationsUseGutter(t *testing.T) {
+	rows := wrapLogBlock("   1 │ ", "one two three\n    - path: /srv/media", "     │ ", 15)
+	want := []string{
+		"   1 │ one two",
+		"     │ three",
+		"     │     -",
+		"     │ path:",
+		"     │ /srv/med",
+		"     │ ia",
+	}
+	if !slices.Equal(rows, want) {
+		t.Fatalf("rows = %q, want %q", rows, want)
+	}
+}
+
+func TestScrollToSearchMatch_AccountsForWrappedRows(t *testing.T) {
+	m := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{LogWrap: true}})
+	m.width, m.height = 40, 20
+	m.initLogState()
+	for i := range 20 {
+		m.logState.rawLines = append(m.logState.rawLines, spindle.LogEvent{
+			Sequence: uint64(i + 1),
+			Level:    "INFO",
+			Message:  strings.Repeat("word ", 12),
+		})
+	}
+	m.logState.searchQuery = "word"
+	m.logState.searchRegex = regexp.MustCompile("word")
+	m.logState.searchMatches = []int{15}
+	m.logState.contentVersion++
+
+	m.scrollToSearchMatch()
+
+	row := m.logState.rowOffsets[15]
+	if row <= 15 {
+		t.Fatalf("row offset = %d, want wrapped rows before line 16", row)
+	}
+	if want := row - m.logViewport.Height()/2; m.logViewport.YOffset() != want {
+		t.Fatalf("YOffset = %d, want %d (match row %d centered)", m.logViewport.YOffset(), want, row)
+	}
+	lines := strings.Split(stripANSI(m.logViewport.GetContent()), "\n")
+	if !strings.HasPrefix(lines[row], "  16 │ ") {
+		t.Fatalf("row %d = %q, want line 16's first row", row, lines[row])
+	}
+	if strings.HasPrefix(lines[row+1], "  16") {
+		t.Fatalf("continuation row repeats the line number: %q", lines[row+1])
+	}
+}
+
+func TestLogProblemLines(t *testing.T) {
+	events := []spindle.LogEvent{
+		{Level: "INFO"},
+		{Level: "warning"},
+		{Level: "DEBUG"},
+		{Level: "[ERROR]"},
+		{Level: "critical"},
+		{Level: "info"},
+	}
+	if got, want := logProblemLines(events), []int{1, 3, 4}; !slices.Equal(got, want) {
+		t.Fatalf("logProblemLines = %v, want %v", got, want)
+	}
+	if got := logProblemLines(nil); got != nil {
+		t.Fatalf("logProblemLines(nil) = %v, want nil", got)
+	}
+}
+
+func TestNextProblemLine(t *testing.T) {
+	lines := []int{2, 5, 9}
+	tests := []struct {
+		from        int
+		forward     bool
+		want        int
+		wantWrapped string
+	}{
+		{-1, true, 2, ""},
+		{2, true, 5, ""},
+		{6, true, 9, ""},
+		{9, true, 2, "top"},
+		{9, false, 5, ""},
+		{5, false, 2, ""},
+		{2, false, 9, "bottom"},
+		{12, false, 9, ""},
+	}
+	for _, tt := range tests {
+		got, wrapped := nextProblemLine(lines, tt.from, tt.forward)
+		if got != tt.want || wrapped != tt.wantWrapped {
+			t.Errorf("nextProblemLine(%d, forward=%v) = %d, %q; want %d, %q", tt.from, tt.forward, got, wrapped, tt.want, tt.wantWrapped)
+		}
+	}
+	if got, _ := nextProblemLine(nil, 0, true); got != -1 {
+		t.Errorf("nextProblemLine(nil) = %d, want -1", got)
+	}
+}
+
+func TestJumpProblemLine_WrapsWithIndicator(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.width, m.height = 80, 20
+	m.initLogState()
+	m.currentView = ViewLogs
+	m.logState.follow = false
+	m.logState.rawLines = []spindle.LogEvent{
+		{Sequence: 1, Level: "INFO", Message: "start"},
+		{Sequence: 2, Level: "WARN", Message: "slow disc"},
+		{Sequence: 3, Level: "INFO", Message: "ripping"},
+		{Sequence: 4, Level: "ERROR", Message: "encode failed"},
+	}
+	m.logState.contentVersion++
+
+	for _, want := range []int{1, 3, 1} {
+		updated, _ := m.handleLogsKey(tea.KeyPressMsg{Code: ']', Text: "]"})
+		m = updated.(Model)
+		if m.logState.problemLine != want {
+			t.Fatalf("problemLine = %d, want %d", m.logState.problemLine, want)
+		}
+	}
+	status := stripANSI(m.renderLogStatus(m.theme.Styles()))
+	if !strings.Contains(status, "problem 1/2 (wrapped to top)") {
+		t.Fatalf("status = %q, want the wrapped problem position", status)
+	}
+
+	updated, _ := m.handleLogsKey(tea.KeyPressMsg{Code: '[', Text: "["})
+	m = updated.(Model)
+	if m.logState.problemLine != 3 || m.logState.problemWrapped != "bottom" {
+		t.Fatalf("problemLine = %d wrapped %q, want 3 wrapped to bottom", m.logState.problemLine, m.logState.problemWrapped)
+	}
+}
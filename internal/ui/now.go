@@ -18,7 +18,7 @@ func (m Model) renderNowBand() string {
 
 // nowBandContent composes the NOW band segments.
 func (m Model) nowBandContent(styles Styles) string {
-	compact := m.width < compactWidthThreshold
+	compact := m.compact()
 
 	label := styles.FaintText.Bold(true).Render("NOW ")
 	sep := styles.Band.Render(" ") + styles.RuleText.Render("|") + styles.Band.Render(" ")
@@ -84,15 +84,15 @@ func (m Model) holderExtras(h spindle.ResourceHolder) []string {
 				continue
 			}
 			var extras []string
-			if pct := t.Progress.Percent; pct > 0 {
+			if pct := taskPercent(*item, t); pct > 0 {
 				extras = append(extras, fmt.Sprintf("%.0f%%", pct))
 			}
 			if t.Type == "encoding" && item.Encoding != nil {
 				if item.Encoding.FPS > 0 {
 					extras = append(extras, fmt.Sprintf("%.0f fps", item.Encoding.FPS))
 				}
-				if eta := item.Encoding.ETADuration(); eta > 0 {
-					extras = append(extras, "ETA "+formatDuration(eta))
+				if eta := m.etas.Apply(*item).Encoding.ETADuration(); eta > 0 {
+					extras = append(extras, m.formatETA(eta))
 				}
 			}
 			return extras
@@ -11,7 +11,9 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
+	"github.com/five82/flyer/internal/export"
 	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
 )
 
 // Problems refresh constants
@@ -28,41 +30,103 @@ type problemsState struct {
 	logCursor   uint64
 	lastItemID  int64
 	lastRefresh time.Time
+
+	// Problem signatures for NEW markers: seen holds each item's set as of
+	// its last render; baseline is the set from before the current visit
+	// (nil on a first visit, which marks nothing).
+	seen     map[int64]map[string]bool
+	baseline map[string]bool
+	visitID  int64
+}
+
+// beginProblemsVisit starts a Problems tab visit for an item, fixing the
+// baseline that NEW markers compare against.
+func (m *Model) beginProblemsVisit(itemID int64) {
+	m.problemsState.visitID = itemID
+	m.problemsState.baseline = m.problemsState.seen[itemID]
+}
+
+// problemMarker returns a mark func for rendering one item's problems and
+// a commit func that records the rendered signatures. mark returns a NEW
+// badge for signatures absent at the previous visit.
+func (m *Model) problemMarker(itemID int64, styles Styles) (mark func(sig string) string, commit func()) {
+	current := make(map[string]bool)
+	baseline := m.problemsState.baseline
+	visiting := m.problemsState.visitID == itemID && baseline != nil
+	mark = func(sig string) string {
+		current[sig] = true
+		if visiting && !baseline[sig] {
+			return " " + styles.AccentText.Bold(true).Render("NEW")
+		}
+		return ""
+	}
+	commit = func() {
+		if m.problemsState.seen == nil {
+			m.problemsState.seen = make(map[int64]map[string]bool)
+		}
+		m.problemsState.seen[itemID] = current
+	}
+	return mark, commit
 }
 
 // --- Global triage view ---
 
-// getTriageItems returns all items needing operator attention (review or
-// failed), in queue priority order.
-func (m *Model) getTriageItems() []spindle.QueueItem {
-	var items []spindle.QueueItem
-	for _, item := range m.snapshot.Queue {
-		if item.NeedsReview || strings.EqualFold(item.Stage, "failed") {
-			items = append(items, item)
+// Problem is one item needing operator attention, with a one-line summary
+// of what's wrong.
+type Problem struct {
+	Item    spindle.QueueItem
+	Summary string
+}
+
+// collectProblems scans the whole queue for items with problems: failed
+// or review items, error messages, failed episodes, or encoding errors.
+// Results are in queue priority order.
+func collectProblems(snapshot state.Snapshot) []Problem {
+	var problems []Problem
+	for _, item := range snapshot.Queue {
+		if hasProblem(item) {
+			problems = append(problems, Problem{Item: item, Summary: triageLeadReason(item)})
 		}
 	}
-	sort.SliceStable(items, func(i, j int) bool {
-		pi, pj := itemSortRank(items[i]), itemSortRank(items[j])
+	sort.SliceStable(problems, func(i, j int) bool {
+		pi, pj := itemSortRank(problems[i].Item), itemSortRank(problems[j].Item)
 		if pi != pj {
 			return pi < pj
 		}
-		return items[i].ID < items[j].ID
+		return problems[i].Item.ID < problems[j].Item.ID
 	})
-	return items
+	return problems
+}
+
+// hasProblem reports whether an item belongs in the problems view.
+func hasProblem(item spindle.QueueItem) bool {
+	if item.NeedsReview || strings.EqualFold(item.Stage, "failed") || strings.TrimSpace(item.ErrorMessage) != "" {
+		return true
+	}
+	if item.Encoding != nil && item.Encoding.Error != nil {
+		return true
+	}
+	episodes, _ := item.EpisodeSnapshot()
+	return len(spindle.FilterFailed(episodes)) > 0
+}
+
+// getProblems returns the problems the triage view lists.
+func (m *Model) getProblems() []Problem {
+	return collectProblems(m.snapshot)
 }
 
 // getTriageItem returns the selected triage item.
 func (m *Model) getTriageItem() *spindle.QueueItem {
-	items := m.getTriageItems()
-	if m.problemsRow < 0 || m.problemsRow >= len(items) {
+	problems := m.getProblems()
+	if m.problemsRow < 0 || m.problemsRow >= len(problems) {
 		return nil
 	}
-	return &items[m.problemsRow]
+	return &problems[m.problemsRow].Item
 }
 
 // clampProblemsRow keeps the triage selection within bounds.
 func (m *Model) clampProblemsRow() {
-	if count := len(m.getTriageItems()); m.problemsRow >= count {
+	if count := len(m.getProblems()); m.problemsRow >= count {
 		m.problemsRow = max(count-1, 0)
 	}
 }
@@ -74,46 +138,50 @@ func (m *Model) problemsVisibleRows() int {
 }
 
 // renderProblems renders the global triage list as a Level 1 panel: every
-// failed/review item with its lead reason. Enter drills into the item's
+// item with a problem and its lead reason. Enter drills into the item's
 // Problems tab.
 func (m Model) renderProblems() string {
 	styles := m.theme.Styles()
 	visibleRows := m.problemsVisibleRows()
 
-	items := m.getTriageItems()
+	problems := m.getProblems()
 
 	var lines []string
 	footer := ""
-	if len(items) == 0 {
-		lines = append(lines, styles.SuccessText.Render("No failed or review items"))
+	if len(problems) == 0 {
+		lines = append(lines, styles.SuccessText.Render("No items with problems"))
 	} else {
-		scroll := clampQueueScroll(m.problemsScroll, m.problemsRow, visibleRows, len(items))
-		end := min(scroll+visibleRows, len(items))
+		scroll := clampQueueScroll(m.problemsScroll, m.problemsRow, visibleRows, len(problems))
+		end := min(scroll+visibleRows, len(problems))
 		for i := scroll; i < end; i++ {
-			lines = append(lines, m.renderTriageRow(items[i], i == m.problemsRow, styles))
+			lines = append(lines, m.renderTriageRow(problems[i], i == m.problemsRow, styles))
 		}
-		footer = scrollRangeFooter(scroll, end, len(items), visibleRows)
+		footer = scrollRangeFooter(scroll, end, len(problems), visibleRows)
 	}
 	for len(lines) < visibleRows {
 		lines = append(lines, "")
 	}
 
-	title := fmt.Sprintf("Problems (%d)", len(items))
+	title := fmt.Sprintf("Problems (%d)", len(problems))
 	return renderPanel(title, strings.Join(lines, "\n"), footer, m.width, styles)
 }
 
 // renderTriageRow renders one triage list row: marker, id, title, reason.
-func (m Model) renderTriageRow(item spindle.QueueItem, selected bool, styles Styles) string {
-	marker, markerStyle := "?", styles.WarningText
-	if strings.EqualFold(item.Stage, "failed") {
+func (m Model) renderTriageRow(p Problem, selected bool, styles Styles) string {
+	item := p.Item
+	marker, markerStyle := "!", styles.WarningText
+	switch {
+	case strings.EqualFold(item.Stage, "failed"):
 		marker, markerStyle = "✗", styles.DangerText
+	case item.NeedsReview:
+		marker = "?"
 	}
 
 	inner := panelInnerWidth(m.width)
 	idStr := fmt.Sprintf("#%d", item.ID)
 	title := truncate(composeTitle(item), 40)
 	reasonWidth := max(inner-(2+len(idStr)+1+lipgloss.Width(title)+2), 10)
-	reason := truncate(triageLeadReason(item), reasonWidth)
+	reason := truncate(p.Summary, reasonWidth)
 
 	if selected {
 		line := fmt.Sprintf("%s %s %s  %s", marker, idStr, title, reason)
@@ -144,6 +212,26 @@ func triageLeadReason(item spindle.QueueItem) string {
 	if msg := strings.TrimSpace(item.ErrorMessage); msg != "" {
 		return msg
 	}
+	if enc := item.Encoding; enc != nil && enc.Error != nil {
+		reason := "Encode error"
+		for _, part := range []string{enc.Error.Title, enc.Error.Message} {
+			if part = strings.TrimSpace(part); part != "" {
+				reason += ": " + part
+			}
+		}
+		return reason
+	}
+	episodes, _ := item.EpisodeSnapshot()
+	if failed := spindle.FilterFailed(episodes); len(failed) > 0 {
+		reason := formatEpisodeLabel(failed[0]) + " failed"
+		if len(failed) > 1 {
+			reason = fmt.Sprintf("%d episodes failed", len(failed))
+		}
+		if msg := strings.TrimSpace(failed[0].ErrorMessage); msg != "" {
+			reason += ": " + msg
+		}
+		return reason
+	}
 	if stage := strings.TrimSpace(item.FailedAtStage); stage != "" {
 		return stageDisplay(stage).label + " failed"
 	}
@@ -162,19 +250,22 @@ func (m Model) handleProblemsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, m.keys.InspectLogs):
 		return m.openInspector(tabLogs)
 
+	case key.Matches(msg, m.keys.CopyReport):
+		return m.copyProblemReport()
+
 	case key.Matches(msg, m.keys.Escape):
 		m.currentView = ViewQueue
 		return m, nil
 	}
 
-	items := m.getTriageItems()
-	if len(items) == 0 {
+	problems := m.getProblems()
+	if len(problems) == 0 {
 		return m, nil
 	}
 
 	switch {
 	case key.Matches(msg, m.keys.Down):
-		if m.problemsRow < len(items)-1 {
+		if m.problemsRow < len(problems)-1 {
 			m.problemsRow++
 		}
 	case key.Matches(msg, m.keys.Up):
@@ -184,13 +275,31 @@ func (m Model) handleProblemsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, m.keys.Top):
 		m.problemsRow = 0
 	case key.Matches(msg, m.keys.Bottom):
-		m.problemsRow = len(items) - 1
+		m.problemsRow = len(problems) - 1
 	}
-	m.problemsScroll = clampQueueScroll(m.problemsScroll, m.problemsRow, m.problemsVisibleRows(), len(items))
+	m.problemsScroll = clampQueueScroll(m.problemsScroll, m.problemsRow, m.problemsVisibleRows(), len(problems))
 
 	return m, nil
 }
 
+// copyProblemReport copies the selected item's plain-text problem report
+// to the clipboard via OSC 52, which also works over SSH.
+func (m Model) copyProblemReport() (tea.Model, tea.Cmd) {
+	item := m.getTriageItem()
+	if item == nil {
+		return m, nil
+	}
+	var b strings.Builder
+	if err := export.WriteProblemReport(&b, *item); err != nil {
+		m.errorMsg = "Report failed: " + err.Error()
+		m.errorExpiry = time.Now().Add(5 * time.Second)
+		return m, nil
+	}
+	m.errorMsg = fmt.Sprintf("Copied problem report for #%d", item.ID)
+	m.errorExpiry = time.Now().Add(5 * time.Second)
+	return m, tea.SetClipboard(b.String())
+}
+
 // --- Inspector Problems tab ---
 
 // renderItemProblems renders the full problems content for an item:
@@ -224,12 +333,15 @@ func (m *Model) renderItemProblems(item *spindle.QueueItem) string {
 
 // renderStructuredProblems extracts problem info from the item's structured data.
 func (m *Model) renderStructuredProblems(b *strings.Builder, item *spindle.QueueItem, styles Styles) {
+	mark, commit := m.problemMarker(item.ID, styles)
+	defer commit()
+
 	// Failed task leads: it's the most direct answer to "what broke".
-	m.renderFailedTaskSection(b, item, styles)
+	m.renderFailedTaskSection(b, item, styles, mark)
 
 	// Review reasons
 	if item.NeedsReview && len(item.ReviewReasons) > 0 {
-		m.renderProblemSection(b, "Review Reasons", styles.WarningText)
+		m.renderProblemSection(b, "Review Reasons", "", styles.WarningText)
 		for _, reason := range item.ReviewReasons {
 			if reason = strings.TrimSpace(reason); reason == "" {
 				continue
@@ -238,6 +350,7 @@ func (m *Model) renderStructuredProblems(b *strings.Builder, item *spindle.Queue
 			b.WriteString(styles.WarningText.Render("•"))
 			b.WriteString(" ")
 			b.WriteString(styles.Text.Render(reason))
+			b.WriteString(mark("review:" + reason))
 			b.WriteString("\n")
 		}
 		b.WriteString("\n")
@@ -245,7 +358,7 @@ func (m *Model) renderStructuredProblems(b *strings.Builder, item *spindle.Queue
 
 	// Error message
 	if msg := strings.TrimSpace(item.ErrorMessage); msg != "" {
-		m.renderProblemSection(b, "Error", styles.DangerText)
+		m.renderProblemSection(b, "Error", mark("error:"+msg), styles.DangerText)
 		b.WriteString("  ")
 		b.WriteString(styles.Text.Render(msg))
 		b.WriteString("\n\n")
@@ -254,7 +367,7 @@ func (m *Model) renderStructuredProblems(b *strings.Builder, item *spindle.Queue
 	// Per-episode errors
 	failedEpisodes := spindle.FilterFailed(item.Episodes)
 	if len(failedEpisodes) > 0 {
-		m.renderProblemSection(b, "Failed Episodes", styles.DangerText)
+		m.renderProblemSection(b, "Failed Episodes", "", styles.DangerText)
 		for _, ep := range failedEpisodes {
 			epLabel := ep.Key
 			if ep.Title != "" {
@@ -264,6 +377,7 @@ func (m *Model) renderStructuredProblems(b *strings.Builder, item *spindle.Queue
 			b.WriteString(styles.DangerText.Render("✗"))
 			b.WriteString(" ")
 			b.WriteString(styles.Text.Render(epLabel))
+			b.WriteString(mark("episode:" + ep.Key + ":" + strings.TrimSpace(ep.ErrorMessage)))
 			b.WriteString("\n")
 			if msg := strings.TrimSpace(ep.ErrorMessage); msg != "" {
 				b.WriteString("      ")
@@ -278,7 +392,7 @@ func (m *Model) renderStructuredProblems(b *strings.Builder, item *spindle.Queue
 	if item.Encoding != nil && item.Encoding.Error != nil {
 		err := item.Encoding.Error
 		if strings.TrimSpace(err.Title) != "" || strings.TrimSpace(err.Message) != "" {
-			m.renderProblemSection(b, "Encoding Error", styles.DangerText)
+			m.renderProblemSection(b, "Encoding Error", mark("encoding:"+err.Title+":"+err.Message), styles.DangerText)
 			if err.Title != "" {
 				b.WriteString("  ")
 				b.WriteString(styles.Text.Render(err.Title))
@@ -307,7 +421,7 @@ func (m *Model) renderStructuredProblems(b *strings.Builder, item *spindle.Queue
 
 	// Encoding warning
 	if item.Encoding != nil && strings.TrimSpace(item.Encoding.Warning) != "" {
-		m.renderProblemSection(b, "Warning", styles.WarningText)
+		m.renderProblemSection(b, "Warning", mark("warning:"+strings.TrimSpace(item.Encoding.Warning)), styles.WarningText)
 		b.WriteString("  ")
 		b.WriteString(styles.Text.Render(item.Encoding.Warning))
 		b.WriteString("\n\n")
@@ -364,16 +478,17 @@ func (m *Model) renderStructuredProblems(b *strings.Builder, item *spindle.Queue
 // failed but the daemon still remembers a failed stage (a retry recompile
 // can transiently drop the task rows), that stage name is shown as a
 // fallback label. Otherwise this renders nothing.
-func (m *Model) renderFailedTaskSection(b *strings.Builder, item *spindle.QueueItem, styles Styles) {
+func (m *Model) renderFailedTaskSection(b *strings.Builder, item *spindle.QueueItem, styles Styles, mark func(string) string) {
 	danger := roleStyle("danger", styles)
 
 	if task := item.FailedTask(); task != nil {
-		m.renderProblemSection(b, "Failed Task", danger)
+		m.renderProblemSection(b, "Failed Task", "", danger)
 		b.WriteString("  ")
 		b.WriteString(styles.Text.Bold(true).Render(stageDisplay(task.Type).label))
 		if task.Attempts > 0 {
 			b.WriteString(styles.MutedText.Render(fmt.Sprintf(" (attempt %d)", task.Attempts)))
 		}
+		b.WriteString(mark("task:" + task.Type + ":" + strings.TrimSpace(task.Error)))
 		b.WriteString("\n")
 		if msg := strings.TrimSpace(task.Error); msg != "" {
 			b.WriteString("  ")
@@ -386,17 +501,20 @@ func (m *Model) renderFailedTaskSection(b *strings.Builder, item *spindle.QueueI
 
 	if len(item.Tasks) == 0 {
 		if stage := strings.TrimSpace(item.FailedAtStage); stage != "" {
-			m.renderProblemSection(b, "Failed Task", danger)
+			m.renderProblemSection(b, "Failed Task", "", danger)
 			b.WriteString("  ")
 			b.WriteString(styles.Text.Bold(true).Render(stageDisplay(stage).label))
+			b.WriteString(mark("stage:" + stage))
 			b.WriteString("\n\n")
 		}
 	}
 }
 
-// renderProblemSection renders a section header for problems.
-func (m *Model) renderProblemSection(b *strings.Builder, title string, titleStyle lipgloss.Style) {
+// renderProblemSection renders a section header for problems, followed by
+// an optional pre-rendered badge.
+func (m *Model) renderProblemSection(b *strings.Builder, title, badge string, titleStyle lipgloss.Style) {
 	b.WriteString(titleStyle.Bold(true).Render(title))
+	b.WriteString(badge)
 	b.WriteString("\n")
 }
 
@@ -434,10 +552,10 @@ func (m *Model) refreshProblemsLogs(item *spindle.QueueItem) tea.Cmd {
 		defer cancel()
 
 		query := spindle.LogQuery{
-			Since:  cursor,
-			Limit:  problemsFetchLimit,
-			ItemID: itemID,
-			Level:  "warn", // Fetch warn and error level logs
+			Since:    cursor,
+			Limit:    problemsFetchLimit,
+			ItemID:   itemID,
+			MinLevel: "warn", // warnings and worse
 		}
 		if cursor == 0 {
 			query.Tail = true
@@ -490,7 +608,7 @@ func (m *Model) handleProblemsLogBatch(msg problemsLogBatchMsg) {
 		if evt.Sequence <= lastSeq {
 			continue
 		}
-		newEvents = append(newEvents, evt)
+		newEvents = append(newEvents, sanitizeLogEvent(evt))
 		lastSeq = evt.Sequence
 	}
 
@@ -1,11 +1,13 @@
 package ui
 
 import (
+	"maps"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
 )
 
 var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*m")
@@ -152,3 +154,75 @@ func TestStyleLogEventHighlightsErrorHint(t *testing.T) {
 		t.Fatalf("highlightErrorHint=true should style error_hint differently than highlightErrorHint=false")
 	}
 }
+
+func TestItemProblems_MarksProblemsNewSinceLastVisit(t *testing.T) {
+	item := spindle.QueueItem{ID: 5, Stage: "ripping", NeedsReview: true, ReviewReasons: []string{"low confidence"}}
+	m := inspectorModelFor(item)
+
+	// First visit: nothing is marked.
+	updated, _ := m.switchInspectorTab(tabProblems)
+	m = updated.(Model)
+	if got := stripANSI(m.renderItemProblems(m.getInspectedItem())); strings.Contains(got, "NEW") {
+		t.Fatalf("first visit must not mark problems NEW, got:\n%s", got)
+	}
+
+	// Leave, then a new problem appears before the second visit.
+	updated, _ = m.switchInspectorTab(tabOverview)
+	m = updated.(Model)
+	item.ReviewReasons = append(item.ReviewReasons, "duplicate title")
+	item.ErrorMessage = "metadata lookup failed"
+	m.snapshot.Queue = []spindle.QueueItem{item}
+
+	updated, _ = m.switchInspectorTab(tabProblems)
+	m = updated.(Model)
+	got := stripANSI(m.renderItemProblems(m.getInspectedItem()))
+	for _, line := range strings.Split(got, "\n") {
+		switch {
+		case strings.Contains(line, "low confidence") && strings.Contains(line, "NEW"):
+			t.Fatalf("previously seen reason marked NEW: %q", line)
+		case strings.Contains(line, "duplicate title") && !strings.Contains(line, "NEW"):
+			t.Fatalf("new reason not marked NEW: %q", line)
+		case strings.HasPrefix(line, "Error") && !strings.Contains(line, "NEW"):
+			t.Fatalf("new error section not marked NEW: %q", line)
+		}
+	}
+
+	// A third visit with nothing new marks nothing.
+	updated, _ = m.switchInspectorTab(tabOverview)
+	m = updated.(Model)
+	updated, _ = m.switchInspectorTab(tabProblems)
+	m = updated.(Model)
+	if got := stripANSI(m.renderItemProblems(m.getInspectedItem())); strings.Contains(got, "NEW") {
+		t.Fatalf("unchanged problems must not stay NEW, got:\n%s", got)
+	}
+}
+
+func TestCollectProblems_MixedQueue(t *testing.T) {
+	snap := state.Snapshot{Queue: []spindle.QueueItem{
+		{ID: 1, Stage: "completed"},
+		{ID: 2, Stage: "encoding", Encoding: &spindle.EncodingStatus{Percent: 40}},
+		{ID: 3, Stage: "failed", Tasks: []spindle.Task{{Type: "ripping", State: "failed", Error: "read error"}}},
+		{ID: 4, Stage: "completed", NeedsReview: true, ReviewReasons: []string{"subtitle no-match"}},
+		{ID: 5, Stage: "encoding", Encoding: &spindle.EncodingStatus{Error: &spindle.EncodingIssue{Title: "Crop failed", Message: "no frames"}}},
+		{ID: 6, Stage: "subtitling", Episodes: []spindle.EpisodeStatus{
+			{Season: 1, Episode: 1},
+			{Season: 1, Episode: 2, Status: "failed", ErrorMessage: "whisper crashed"},
+		}},
+		{ID: 7, Stage: "organizing", ErrorMessage: "library path missing"},
+	}}
+
+	got := map[int64]string{}
+	for _, p := range collectProblems(snap) {
+		got[p.Item.ID] = p.Summary
+	}
+	want := map[int64]string{
+		3: "Ripping failed: read error",
+		4: "subtitle no-match",
+		5: "Encode error: Crop failed: no frames",
+		6: "S01E02 failed: whisper crashed",
+		7: "library path missing",
+	}
+	if !maps.Equal(got, want) {
+		t.Fatalf("collectProblems() summaries = %v, want %v", got, want)
+	}
+}
@@ -1,60 +1,87 @@
 package ui
 
 import (
+	"cmp"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"charm.land/lipgloss/v2"
 
+	"github.com/five82/flyer/internal/export"
 	"github.com/five82/flyer/internal/spindle"
 )
 
 // updateQueueTable updates selection bounds when queue changes.
 // Preserves selection by item ID when possible.
 func (m *Model) updateQueueTable() {
-	// Get the currently selected item's ID before updating
 	var selectedID int64
 	if item := m.getSelectedItem(); item != nil {
 		selectedID = item.ID
 	}
+	m.selectQueueItem(selectedID)
+}
 
-	items := m.getSortedItems()
-	itemCount := len(items)
-
-	if itemCount == 0 {
-		m.selectedRow = 0
-		return
+// getSelectedItem returns the currently selected queue item, nil when the
+// selection is on a lane header.
+func (m *Model) getSelectedItem() *spindle.QueueItem {
+	rows := m.queueRows()
+	if m.selectedRow < 0 || m.selectedRow >= len(rows) {
+		return nil
 	}
+	return rows[m.selectedRow].item
+}
 
-	// Try to find the previously selected item by ID
-	if selectedID > 0 {
-		for i, item := range items {
-			if item.ID == selectedID {
-				m.selectedRow = i
-				return
-			}
-		}
-	}
+// sortedItemsCache memoizes getSortedItems across renders. The model holds
+// it by pointer so value copies of the model share one cache.
+type sortedItemsCache struct {
+	version uint64 // snapshot version it was built from
+	filter  QueueFilter
+	reason  string
+	query   string
+	sort    QueueSort
+	desc    bool
+	items   []spindle.QueueItem
+}
 
-	// Item not found - clamp to valid range
-	if m.selectedRow >= itemCount {
-		m.selectedRow = itemCount - 1
-	}
+// matches reports whether the cache was built from the same inputs. A
+// zero snapshot version never matches, since it does not come from a store.
+func (c *sortedItemsCache) matches(m *Model) bool {
+	return c.version != 0 && c.version == m.snapshot.Version &&
+		c.filter == m.filterMode && c.reason == m.reviewReason && c.query == m.queueFilterQuery &&
+		c.sort == m.queueSort && c.desc == m.queueSortDesc
 }
 
-// getSelectedItem returns the currently selected queue item.
-func (m *Model) getSelectedItem() *spindle.QueueItem {
-	items := m.getSortedItems()
-	if m.selectedRow < 0 || m.selectedRow >= len(items) {
-		return nil
+// getSortedItems returns queue items filtered and in the current sort
+// order. The result is cached until the snapshot, filters or sort change;
+// callers must not modify it.
+func (m *Model) getSortedItems() []spindle.QueueItem {
+	c := m.sortedCache
+	if c != nil && c.items != nil && c.matches(m) {
+		return c.items
+	}
+	items := m.sortItems()
+	if c != nil {
+		*c = sortedItemsCache{
+			version: m.snapshot.Version,
+			filter:  m.filterMode,
+			reason:  m.reviewReason,
+			query:   m.queueFilterQuery,
+			sort:    m.queueSort,
+			desc:    m.queueSortDesc,
+			items:   items,
+		}
 	}
-	return &items[m.selectedRow]
+	return items
 }
 
-// getSortedItems returns queue items filtered and sorted by priority.
-func (m *Model) getSortedItems() []spindle.QueueItem {
+// sortItems filters and sorts the snapshot queue.
+func (m *Model) sortItems() []spindle.QueueItem {
 	items := make([]spindle.QueueItem, 0, len(m.snapshot.Queue))
 	query := strings.ToLower(m.queueFilterQuery)
 
@@ -66,13 +93,17 @@ func (m *Model) getSortedItems() []spindle.QueueItem {
 				continue
 			}
 		case FilterReview:
-			if !item.NeedsReview {
+			if !item.NeedsReview || (m.reviewReason != "" && !hasReviewReason(item, m.reviewReason)) {
 				continue
 			}
 		case FilterProcessing:
 			if !isProcessingItem(item) {
 				continue
 			}
+		case FilterPinned:
+			if !m.isPinned(item.ID) {
+				continue
+			}
 		}
 		if query != "" && !queueItemMatches(item, query) {
 			continue
@@ -81,24 +112,76 @@ func (m *Model) getSortedItems() []spindle.QueueItem {
 	}
 
 	sort.SliceStable(items, func(i, j int) bool {
-		// Review first, then failed, then live work, then by ID ascending
-		// (spindle's processing order).
-		pi, pj := itemSortRank(items[i]), itemSortRank(items[j])
-		if pi != pj {
-			return pi < pj
+		c := compareQueueItems(items[i], items[j], m.queueSort)
+		if m.queueSortDesc {
+			c = -c
 		}
+		if c != 0 {
+			return c < 0
+		}
+		// Ties keep spindle's processing order.
 		return items[i].ID < items[j].ID
 	})
 
 	return items
 }
 
+// compareQueueItems orders two items ascending by key, 0 on a tie. The
+// priority order puts review first, then failed, then live work.
+func compareQueueItems(a, b spindle.QueueItem, key QueueSort) int {
+	switch key {
+	case SortID:
+		return cmp.Compare(a.ID, b.ID)
+	case SortTitle:
+		return strings.Compare(strings.ToLower(composeTitle(a)), strings.ToLower(composeTitle(b)))
+	case SortUpdated:
+		return parseTimestamp(a.UpdatedAt).Compare(parseTimestamp(b.UpdatedAt))
+	case SortProgress:
+		return cmp.Compare(runningTaskPercent(a), runningTaskPercent(b))
+	default:
+		return cmp.Compare(itemSortRank(a), itemSortRank(b))
+	}
+}
+
+// cycleSort moves to the next sort key, each starting in its natural
+// direction: newest updates and most progress first.
+func (m *Model) cycleSort() {
+	m.queueSort = (m.queueSort + 1) % (SortProgress + 1)
+	m.queueSortDesc = m.queueSort == SortUpdated || m.queueSort == SortProgress
+}
+
+// sortLabel returns the display label for the current sort, with an arrow
+// for the direction, e.g. "↓Updated".
+func (m Model) sortLabel() string {
+	var label string
+	switch m.queueSort {
+	case SortID:
+		label = "ID"
+	case SortTitle:
+		label = "Title"
+	case SortUpdated:
+		label = "Updated"
+	case SortProgress:
+		label = "Progress"
+	default:
+		label = "Priority"
+	}
+	if m.queueSortDesc {
+		return "↓" + label
+	}
+	return "↑" + label
+}
+
 // queueItemMatches reports whether an item matches the lowercase text query
-// (substring of the display title or the "#id" form).
+// (substring of the display title, the "#id" form, or the lane name, so
+// "waiting" lists everything queued).
 func queueItemMatches(item spindle.QueueItem, query string) bool {
 	if strings.Contains(strings.ToLower(composeTitle(item)), query) {
 		return true
 	}
+	if strings.Contains(strings.ToLower(itemLane(item)), query) {
+		return true
+	}
 	return strings.Contains(fmt.Sprintf("#%d", item.ID), query)
 }
 
@@ -106,26 +189,39 @@ func queueItemMatches(item spindle.QueueItem, query string) bool {
 // terminals only).
 const queueBarWidth = 8
 
+// queueLaneWidth fits the longest lane name ("Attention").
+const queueLaneWidth = 9
+
 // queueColumns holds the computed fixed column widths for the queue table.
-// ago == 0 hides the age column (compact terminals).
+// ago == 0 hides the age column (compact terminals); lane == 0 hides the
+// lane column.
 type queueColumns struct {
 	strip int
 	id    int
 	stage int
+	lane  int
 	pct   int
 	ago   int
 	title int
-	bar   bool // pct column includes an inline progress bar
+	bar   bool       // pct column includes an inline progress bar
+	times timeFormat // how the age column shows update times
 }
 
 // computeQueueColumns derives column widths from the item set and terminal
 // width; the title column absorbs the slack of the panel interior. Below 80
 // terminal columns the age column is dropped; at or above the compact
-// threshold the pct column gains an inline progress bar.
-func computeQueueColumns(items []spindle.QueueItem, width int) queueColumns {
-	cols := queueColumns{strip: 1, id: 2, stage: 12, pct: 4, ago: 8}
+// threshold the pct column gains an inline progress bar. The lane column
+// is shown when requested and, like the age column, needs 80 columns.
+// Absolute times widen the age column to fit a date.
+func computeQueueColumns(items []spindle.QueueItem, width int, lane bool, times timeFormat) queueColumns {
+	cols := queueColumns{strip: 1, id: 2, stage: 12, pct: 4, ago: 8, times: times}
+	if times == timeAbsolute {
+		cols.ago = len("Jan 02 15:04")
+	}
 	if width < 80 {
 		cols.ago = 0
+	} else if lane {
+		cols.lane = queueLaneWidth
 	}
 	if width >= compactWidthThreshold {
 		cols.bar = true
@@ -135,7 +231,7 @@ func computeQueueColumns(items []spindle.QueueItem, width int) queueColumns {
 		if n := len(item.Tasks); n > cols.strip {
 			cols.strip = n
 		}
-		idLen := len(fmt.Sprintf("#%d", item.ID)) + 1 // room for review "?"
+		idLen := len(fmt.Sprintf("#%d", item.ID)) + 2 // room for pin "*" and review "?"
 		if idLen > cols.id {
 			cols.id = idLen
 		}
@@ -146,13 +242,16 @@ func computeQueueColumns(items []spindle.QueueItem, width int) queueColumns {
 	if cols.ago > 0 {
 		fixed += cols.ago + 2
 	}
+	if cols.lane > 0 {
+		fixed += cols.lane + 2
+	}
 	cols.title = max(panelInnerWidth(width)-fixed, 10)
 	return cols
 }
 
 // queueFilterLineVisible reports whether the queue filter prompt row is shown.
 func (m *Model) queueFilterLineVisible() bool {
-	return m.queueFilterActive || m.queueFilterQuery != ""
+	return m.queueFilterActive || m.queueFilterQuery != "" || m.queueJumpActive
 }
 
 // queueVisibleRows returns the item rows available to the queue table.
@@ -177,29 +276,34 @@ func (m Model) renderQueue() string {
 	}
 
 	items := m.getSortedItems()
-	cols := computeQueueColumns(items, m.width)
+	rows := m.queueRows()
+	cols := computeQueueColumns(items, m.width, m.prefs.QueueLaneColumn, m.queueTimeFormat())
 	lines = append(lines, renderQueueHeaderRow(cols, styles))
 
 	footer := ""
-	if len(items) == 0 {
+	if len(rows) == 0 {
 		msg := "No items in queue"
 		switch {
 		case m.queueFilterQuery != "":
 			msg = "No items match: " + m.queueFilterQuery
 		case m.filterMode != FilterAll:
-			msg = "No items match filter: " + m.filterLabel()
+			msg = "No items match filter: " + m.filterDescription()
 		}
 		lines = append(lines, styles.MutedText.Render(msg))
 	} else {
 		// Keep the selection visible within the scroll window. The stored
 		// offset is maintained on key handling; re-derive here defensively
 		// so a resize between keypresses cannot hide the selection.
-		scroll := clampQueueScroll(m.queueScroll, m.selectedRow, visibleRows, len(items))
-		end := min(scroll+visibleRows, len(items))
+		scroll := clampQueueScroll(m.queueScroll, m.selectedRow, visibleRows, len(rows))
+		end := min(scroll+visibleRows, len(rows))
 		for i := scroll; i < end; i++ {
-			lines = append(lines, m.renderQueueRow(items[i], cols, i == m.selectedRow, styles))
+			if rows[i].lane != nil {
+				lines = append(lines, m.renderQueueLaneHeader(rows[i], i == m.selectedRow, styles))
+				continue
+			}
+			lines = append(lines, m.renderQueueRow(*rows[i].item, cols, i == m.selectedRow, styles))
 		}
-		footer = scrollRangeFooter(scroll, end, len(items), visibleRows)
+		footer = scrollRangeFooter(scroll, end, len(rows), visibleRows)
 	}
 
 	// Fill the panel to a stable height so the frame does not jump as the
@@ -228,8 +332,12 @@ func boolToInt(b bool) int {
 	return 0
 }
 
-// renderQueueFilterLine renders the "/" filter prompt or the applied query.
+// renderQueueFilterLine renders the ":" jump prompt, the "/" filter prompt
+// or the applied query.
 func (m Model) renderQueueFilterLine(styles Styles) string {
+	if m.queueJumpActive {
+		return styles.AccentText.Render(":") + m.queueJumpInput.View()
+	}
 	if m.queueFilterActive {
 		return styles.AccentText.Render("/") + m.queueFilterInput.View()
 	}
@@ -254,10 +362,17 @@ func renderQueueHeaderRow(cols queueColumns, styles Styles) string {
 		pad("ID", cols.id),
 		pad("TITLE", cols.title),
 		pad("STAGE", cols.stage),
-		pad(pctLabel, cols.pct),
 	}
+	if cols.lane > 0 {
+		parts = append(parts, pad("LANE", cols.lane))
+	}
+	parts = append(parts, pad(pctLabel, cols.pct))
 	if cols.ago > 0 {
-		parts = append(parts, "AGE")
+		label := "AGE"
+		if cols.times == timeAbsolute {
+			label = "UPDATED"
+		}
+		parts = append(parts, label)
 	}
 	return styles.FaintText.Render(strings.Join(parts, "  "))
 }
@@ -276,25 +391,89 @@ func clampQueueScroll(scroll, selected, visible, total int) int {
 
 // ensureQueueVisible updates the stored scroll offset after selection moves.
 func (m *Model) ensureQueueVisible() {
-	m.queueScroll = clampQueueScroll(m.queueScroll, m.selectedRow, m.queueVisibleRows(), len(m.getSortedItems()))
+	m.queueScroll = clampQueueScroll(m.queueScroll, m.selectedRow, m.queueVisibleRows(), len(m.queueRows()))
+}
+
+// isPinned reports whether the item is pinned.
+func (m Model) isPinned(id int64) bool {
+	return slices.Contains(m.prefs.PinnedItems, id)
 }
 
+// togglePin pins or unpins an item and saves the pins. The sorted-items
+// cache does not key on pins, so it is dropped for the pinned filter.
+func (m *Model) togglePin(id int64) {
+	if i := slices.Index(m.prefs.PinnedItems, id); i >= 0 {
+		m.prefs.PinnedItems = slices.Delete(slices.Clone(m.prefs.PinnedItems), i, i+1)
+	} else {
+		m.prefs.PinnedItems = append(slices.Clone(m.prefs.PinnedItems), id)
+	}
+	m.savePrefs()
+	if m.sortedCache != nil {
+		m.sortedCache.items = nil
+	}
+	m.updateQueueTable()
+}
+
+// timeFormat selects how the queue shows update times.
+type timeFormat int
+
+const (
+	timeRelative timeFormat = iota // "5m ago"
+	timeAbsolute                   // "14:03:27", or "Jan 02 14:03" on other days
+)
+
+// queueTimeFormat returns the queue's time format from prefs.
+func (m Model) queueTimeFormat() timeFormat {
+	if m.prefs.QueueAbsoluteTimes {
+		return timeAbsolute
+	}
+	return timeRelative
+}
+
+// formatUpdated formats an update time relative to now or as a clock time,
+// adding the date when it falls on another day than now. Zero times
+// render empty.
+func formatUpdated(updated, now time.Time, format timeFormat) string {
+	if updated.IsZero() {
+		return ""
+	}
+	if format == timeRelative {
+		return humanizeDuration(now.Sub(updated))
+	}
+	updated = updated.In(now.Location())
+	if y, mo, d := updated.Date(); y == now.Year() && mo == now.Month() && d == now.Day() {
+		return updated.Format("15:04:05")
+	}
+	return updated.Format("Jan 02 15:04")
+}
+
+// dupBadge marks queue titles whose disc another item has also ripped.
+const dupBadge = " DUP"
+
 // renderQueueRow renders one queue table row:
-// strip  id  title  stage  pct  ago
+// strip  id  title  stage  [lane]  pct  ago
 // The selected row renders as one selection-colored bar (no per-cell colors,
 // guaranteeing contrast); other rows use per-cell styling.
 func (m Model) renderQueueRow(item spindle.QueueItem, cols queueColumns, selected bool, styles Styles) string {
 	idStr := fmt.Sprintf("#%d", item.ID)
+	pinned := m.isPinned(item.ID)
+	if pinned {
+		idStr += "*"
+	}
 	if item.NeedsReview {
 		idStr += "?"
 	}
-	title := truncate(composeTitle(item), cols.title)
+	// Duplicate discs keep a DUP badge at the end of the title cell.
+	dup := len(m.duplicates[item.ID]) > 0
+	title := truncate(item.FullTitle(), cols.title)
+	if dup {
+		title = truncate(item.FullTitle(), max(cols.title-len(dupBadge), 1))
+	}
 	stage, stageStyle := queueStageCell(item, styles)
+	lane, laneStyle := m.queueLaneCell(item, styles)
 	ago := ""
 	if cols.ago > 0 {
-		if updated := parseTimestamp(item.UpdatedAt); !updated.IsZero() {
-			ago = humanizeDuration(time.Since(updated))
-		}
+		ago = formatUpdated(parseTimestamp(item.UpdatedAt), m.clock(), cols.times)
 	}
 
 	pad := func(s string, w int) string {
@@ -305,13 +484,20 @@ func (m Model) renderQueueRow(item spindle.QueueItem, cols queueColumns, selecte
 	}
 
 	if selected {
+		titleCell := title
+		if dup {
+			titleCell += dupBadge
+		}
 		fields := []string{
 			pad(plainTaskStrip(item), cols.strip),
 			pad(idStr, cols.id),
-			pad(title, cols.title),
+			pad(titleCell, cols.title),
 			pad(stage, cols.stage),
-			pad(m.queueProgressCell(item, cols, stageStyle, styles, true), cols.pct),
 		}
+		if cols.lane > 0 {
+			fields = append(fields, pad(lane, cols.lane))
+		}
+		fields = append(fields, pad(m.queueProgressCell(item, cols, stageStyle, styles, true), cols.pct))
 		if cols.ago > 0 {
 			fields = append(fields, ago)
 		}
@@ -323,17 +509,27 @@ func (m Model) renderQueueRow(item spindle.QueueItem, cols queueColumns, selecte
 	}
 
 	idStyle := styles.MutedText
-	if item.NeedsReview {
+	switch {
+	case item.NeedsReview:
 		idStyle = styles.WarningText
+	case pinned:
+		idStyle = styles.AccentText
 	}
 
+	titleCell := styles.Text.Render(title)
+	if dup {
+		titleCell += styles.WarningText.Render(dupBadge)
+	}
 	parts := []string{
 		pad(m.renderTaskStrip(item, styles), cols.strip),
 		idStyle.Render(pad(idStr, cols.id)),
-		styles.Text.Render(pad(title, cols.title)),
+		pad(titleCell, cols.title),
 		stageStyle.Render(pad(stage, cols.stage)),
-		pad(m.queueProgressCell(item, cols, stageStyle, styles, false), cols.pct),
 	}
+	if cols.lane > 0 {
+		parts = append(parts, laneStyle.Render(pad(lane, cols.lane)))
+	}
+	parts = append(parts, pad(m.queueProgressCell(item, cols, stageStyle, styles, false), cols.pct))
 	if cols.ago > 0 {
 		parts = append(parts, styles.FaintText.Render(ago))
 	}
@@ -366,8 +562,8 @@ func (m Model) queueProgressCell(item spindle.QueueItem, cols queueColumns, stag
 // runningTaskPercent returns the primary running task's percent.
 func runningTaskPercent(item spindle.QueueItem) float64 {
 	for _, t := range item.Tasks {
-		if t.IsRunning() && t.Progress.Percent > 0 {
-			return clampPercent(t.Progress.Percent)
+		if pct := taskPercent(item, t); t.IsRunning() && pct > 0 {
+			return clampPercent(pct)
 		}
 	}
 	return 0
@@ -398,8 +594,8 @@ func queueStageCell(item spindle.QueueItem, styles Styles) (string, lipgloss.Sty
 // running task's percent, or blank.
 func queuePercentCell(item spindle.QueueItem) string {
 	for _, t := range item.Tasks {
-		if t.IsRunning() && t.Progress.Percent > 0 {
-			return fmt.Sprintf("%3.0f%%", clampPercent(t.Progress.Percent))
+		if pct := taskPercent(item, t); t.IsRunning() && pct > 0 {
+			return fmt.Sprintf("%3.0f%%", clampPercent(pct))
 		}
 	}
 	return ""
@@ -455,8 +651,9 @@ func plainTaskStrip(item spindle.QueueItem) string {
 	return b.String()
 }
 
-// composeTitle builds the display title for an item, preferring the
-// server-computed one.
+// composeTitle builds the raw title for an item, preferring the
+// server-computed one. Sorting, filtering and narrow labels use it; the
+// queue, completed and inspector titles use the richer FullTitle.
 func composeTitle(item spindle.QueueItem) string {
 	if item.DisplayTitle != "" {
 		return item.DisplayTitle
@@ -467,19 +664,63 @@ func composeTitle(item spindle.QueueItem) string {
 	return fmt.Sprintf("Item #%d", item.ID)
 }
 
+// exportQueue writes the snapshot's queue to dir as JSON and CSV files
+// named for the current time, returning a status message for the header.
+func (m Model) exportQueue(dir string) string {
+	base := filepath.Join(dir, "flyer-queue-"+m.clock().Format("20060102-150405"))
+	cols := export.Columns{Lane: itemLane, Progress: exportProgress}
+	for _, f := range []struct {
+		ext   string
+		write func(io.Writer) error
+	}{
+		{".json", func(w io.Writer) error { return export.WriteJSON(w, m.snapshot.Queue) }},
+		{".csv", func(w io.Writer) error { return export.WriteCSV(w, m.snapshot.Queue, cols) }},
+	} {
+		if err := writeExportFile(base+f.ext, f.write); err != nil {
+			return "Export failed: " + err.Error()
+		}
+	}
+	return fmt.Sprintf("Exported %d items to %s.{json,csv}", len(m.snapshot.Queue), base)
+}
+
+// writeExportFile creates path and fills it with write.
+func writeExportFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// exportProgress is an item's progress percent for export: its running
+// task's, or 100 once completed.
+func exportProgress(item spindle.QueueItem) float64 {
+	if strings.EqualFold(item.Stage, "completed") {
+		return 100
+	}
+	return runningTaskPercent(item)
+}
+
 // getQueueTitle returns the queue rule title with optional filter indicator.
 func (m Model) getQueueTitle() string {
 	items := m.getSortedItems()
 	total := len(m.snapshot.Queue)
 	visible := len(items)
 
-	if m.filterMode == FilterAll {
-		if m.queueFilterQuery != "" {
-			return fmt.Sprintf("Queue (%d/%d)", visible, total)
-		}
-		return fmt.Sprintf("Queue (%d)", total)
+	title := fmt.Sprintf("Queue (%d)", total)
+	switch {
+	case m.filterMode != FilterAll:
+		// Show "Queue (visible/total) FilterName"
+		title = fmt.Sprintf("Queue (%d/%d) %s", visible, total, m.filterDescription())
+	case m.queueFilterQuery != "":
+		title = fmt.Sprintf("Queue (%d/%d)", visible, total)
 	}
-
-	// Show "Queue (visible/total) - FilterName"
-	return fmt.Sprintf("Queue (%d/%d) %s", visible, total, m.filterLabel())
+	if m.queueSort != SortPriority || m.queueSortDesc {
+		title += " " + m.sortLabel()
+	}
+	return title
 }
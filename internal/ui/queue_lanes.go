@@ -0,0 +1,310 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// queueLane is one section of the grouped queue view. Lanes follow the
+// itemSortRank buckets, so grouping never reorders items relative to the
+// flat table.
+type queueLane struct {
+	name  string
+	items []spindle.QueueItem
+}
+
+// queueLaneNames lists the lanes in display order.
+var queueLaneNames = []string{"Attention", "Running", "Waiting", "Done"}
+
+// itemLane returns the lane an item belongs to: review and failed items
+// need attention, then running, waiting, and terminal work.
+func itemLane(item spindle.QueueItem) string {
+	switch itemSortRank(item) {
+	case 0, 1:
+		return "Attention"
+	case 2:
+		return "Running"
+	case 3:
+		return "Waiting"
+	default:
+		return "Done"
+	}
+}
+
+// buildQueueLanes groups sorted items by lane, omitting empty lanes.
+func buildQueueLanes(items []spindle.QueueItem) []queueLane {
+	byLane := make(map[string][]spindle.QueueItem, len(queueLaneNames))
+	for _, item := range items {
+		name := itemLane(item)
+		byLane[name] = append(byLane[name], item)
+	}
+	lanes := make([]queueLane, 0, len(queueLaneNames))
+	for _, name := range queueLaneNames {
+		if len(byLane[name]) > 0 {
+			lanes = append(lanes, queueLane{name: name, items: byLane[name]})
+		}
+	}
+	return lanes
+}
+
+// queueRow is one navigable row of the queue table: an item, or a lane
+// header in the grouped view.
+type queueRow struct {
+	lane  *queueLane // set on lane header rows
+	item  *spindle.QueueItem
+	count int // lane item count (header rows)
+}
+
+// queueRows returns the navigable rows of the queue table. The flat view
+// is the sorted items; the grouped view puts each lane's items under a
+// header, hiding the items of collapsed lanes.
+func (m *Model) queueRows() []queueRow {
+	items := m.getSortedItems()
+	if !m.queueGrouped {
+		rows := make([]queueRow, len(items))
+		for i := range items {
+			rows[i] = queueRow{item: &items[i]}
+		}
+		return rows
+	}
+
+	lanes := buildQueueLanes(items)
+	rows := make([]queueRow, 0, len(items)+len(lanes))
+	for i := range lanes {
+		lane := &lanes[i]
+		rows = append(rows, queueRow{lane: lane, count: len(lane.items)})
+		if m.queueCollapsed[lane.name] {
+			continue
+		}
+		for j := range lane.items {
+			rows = append(rows, queueRow{item: &lane.items[j]})
+		}
+	}
+	return rows
+}
+
+// toggleQueueGrouped switches between the flat and grouped queue views,
+// keeping the selected item selected.
+func (m *Model) toggleQueueGrouped() {
+	var selectedID int64
+	if item := m.getSelectedItem(); item != nil {
+		selectedID = item.ID
+	}
+	m.queueGrouped = !m.queueGrouped
+	m.selectQueueItem(selectedID)
+}
+
+// toggleQueueLane collapses or expands the lane under the selection. The
+// selection moves to the lane header so it stays visible.
+func (m *Model) toggleQueueLane() {
+	rows := m.queueRows()
+	if m.selectedRow < 0 || m.selectedRow >= len(rows) {
+		return
+	}
+	name := ""
+	if row := rows[m.selectedRow]; row.lane != nil {
+		name = row.lane.name
+	} else {
+		name = itemLane(*row.item)
+	}
+	if m.queueCollapsed == nil {
+		m.queueCollapsed = make(map[string]bool)
+	}
+	m.queueCollapsed[name] = !m.queueCollapsed[name]
+	for i, row := range m.queueRows() {
+		if row.lane != nil && row.lane.name == name {
+			m.selectedRow = i
+			break
+		}
+	}
+}
+
+// jumpQueueLane moves the selection to the next (dir > 0) or previous
+// (dir < 0) lane header.
+func (m *Model) jumpQueueLane(dir int) {
+	rows := m.queueRows()
+	for i := m.selectedRow + dir; i >= 0 && i < len(rows); i += dir {
+		if rows[i].lane != nil {
+			m.selectedRow = i
+			return
+		}
+	}
+}
+
+// selectQueueItem selects the row holding the item with the given ID,
+// clamping the selection when it is no longer shown.
+func (m *Model) selectQueueItem(id int64) {
+	rows := m.queueRows()
+	if len(rows) == 0 {
+		m.selectedRow = 0
+		return
+	}
+	if id > 0 {
+		for i, row := range rows {
+			if row.item != nil && row.item.ID == id {
+				m.selectedRow = i
+				return
+			}
+		}
+	}
+	m.selectedRow = min(m.selectedRow, len(rows)-1)
+}
+
+// queueRowForID returns the queue row showing the item with the given ID,
+// -1 when filters or a collapsed lane hide it.
+func (m *Model) queueRowForID(id int64) int {
+	for i, row := range m.queueRows() {
+		if row.item != nil && row.item.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// jumpToQueueItem selects the item with the given ID and scrolls it into
+// view. When the filters hide it they are cleared first, and a collapsed
+// lane holding it is expanded. It reports false when the queue has no such
+// item.
+func (m *Model) jumpToQueueItem(id int64) bool {
+	i := slices.IndexFunc(m.snapshot.Queue, func(item spindle.QueueItem) bool { return item.ID == id })
+	if i < 0 {
+		return false
+	}
+	if m.queueRowForID(id) < 0 {
+		m.filterMode = FilterAll
+		m.queueFilterQuery = ""
+		m.queueFilterInput.SetValue("")
+		if m.queueGrouped {
+			delete(m.queueCollapsed, itemLane(m.snapshot.Queue[i]))
+		}
+	}
+	m.selectedRow = max(m.queueRowForID(id), 0)
+	m.ensureQueueVisible()
+	m.queueLastNav = m.clock()
+	return true
+}
+
+// queueRowIDs returns the item ID of each queue row, zero for lane headers.
+func (m *Model) queueRowIDs() []int64 {
+	rows := m.queueRows()
+	ids := make([]int64, len(rows))
+	for i, row := range rows {
+		if row.item != nil {
+			ids[i] = row.item.ID
+		}
+	}
+	return ids
+}
+
+// reselectQueueItem restores the selection after the queue changed, given
+// the row IDs and selected row from before. The selected item stays
+// selected wherever it moved; when it is gone, the nearest surviving item
+// from the old order takes over, checking the row below before the row
+// above so a finished item hands off to its successor.
+func (m *Model) reselectQueueItem(prevIDs []int64, prevRow int) {
+	defer m.ensureQueueVisible()
+	if prevRow < 0 || prevRow >= len(prevIDs) || prevIDs[prevRow] == 0 {
+		m.selectQueueItem(0)
+		return
+	}
+	present := make(map[int64]bool)
+	for _, id := range m.queueRowIDs() {
+		present[id] = id != 0
+	}
+	for dist := 0; dist < len(prevIDs); dist++ {
+		for _, i := range []int{prevRow + dist, prevRow - dist} {
+			if i >= 0 && i < len(prevIDs) && present[prevIDs[i]] {
+				m.selectQueueItem(prevIDs[i])
+				return
+			}
+		}
+	}
+	m.selectQueueItem(0)
+}
+
+// followActiveItem selects the first item (in row order) that has started
+// running since the previous queue, so an unattended dashboard tracks the
+// work. It stays put while the queue_follow_idle_seconds grace after the
+// last queue navigation runs, and when the pref is zero.
+func (m *Model) followActiveItem(prev []spindle.QueueItem) {
+	idle := time.Duration(m.prefs.QueueFollowIdleSeconds) * time.Second
+	if idle <= 0 || m.clock().Sub(m.queueLastNav) < idle {
+		return
+	}
+	wasRunning := make(map[int64]bool, len(prev))
+	for _, item := range prev {
+		if len(item.RunningTasks()) > 0 {
+			wasRunning[item.ID] = true
+		}
+	}
+	for _, row := range m.queueRows() {
+		if row.item != nil && len(row.item.RunningTasks()) > 0 && !wasRunning[row.item.ID] {
+			m.selectQueueItem(row.item.ID)
+			m.ensureQueueVisible()
+			return
+		}
+	}
+}
+
+// renderQueueLaneHeader renders a lane header row with its item count and
+// collapse marker.
+func (m Model) renderQueueLaneHeader(row queueRow, selected bool, styles Styles) string {
+	marker := "▾"
+	if m.queueCollapsed[row.lane.name] {
+		marker = "▸"
+	}
+	label := fmt.Sprintf("%s %s (%d)", marker, row.lane.name, row.count)
+	if selected {
+		if n := panelInnerWidth(m.width) - len([]rune(label)); n > 0 {
+			label += strings.Repeat(" ", n)
+		}
+		return styles.Selected.Render(label)
+	}
+	return m.laneStyle(row.lane.name, styles).Bold(true).Render(label)
+}
+
+// laneColor returns the configured color for a lane: a prefs override,
+// then the theme's map. A lane neither names (only possible for a theme
+// file that drops one) renders muted.
+func (m Model) laneColor(lane string) string {
+	for name, color := range m.prefs.LaneColors {
+		if strings.EqualFold(name, lane) && strings.TrimSpace(color) != "" {
+			return strings.TrimSpace(color)
+		}
+	}
+	if color, ok := m.theme.LaneColors[strings.ToLower(lane)]; ok {
+		return color
+	}
+	return "muted"
+}
+
+// queueLaneCell returns the lane column text and style for an item. A
+// failed item stands out in danger within the Attention lane, whatever
+// color the lane itself has.
+func (m Model) queueLaneCell(item spindle.QueueItem, styles Styles) (string, lipgloss.Style) {
+	lane := itemLane(item)
+	if strings.EqualFold(item.Stage, "failed") && !item.NeedsReview {
+		return lane, styles.DangerText
+	}
+	return lane, m.laneStyle(lane, styles)
+}
+
+// laneStyle resolves a lane's color to a style.
+func (m Model) laneStyle(lane string, styles Styles) lipgloss.Style {
+	switch color := m.laneColor(lane); strings.ToLower(color) {
+	case "accent", "info", "warning", "success", "danger":
+		return roleStyle(strings.ToLower(color), styles)
+	case "muted":
+		return styles.MutedText
+	case "faint":
+		return styles.FaintText
+	default:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+	}
+}
@@ -0,0 +1,302 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/five82/flyer/internal/prefs"
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
+)
+
+func laneTestItems() []spindle.QueueItem {
+	return []spindle.QueueItem{
+		{ID: 1, Stage: "completed"},
+		{ID: 2, Stage: "encoding", Tasks: []spindle.Task{{Type: "encoding", State: "running"}}},
+		{ID: 3, Stage: "failed"},
+		{ID: 4, Stage: "identification"},
+		{ID: 5, Stage: "ripping", NeedsReview: true},
+		{ID: 6, Stage: "completed"},
+	}
+}
+
+func laneModel() Model {
+	m := New(Options{ThemeName: "slate"})
+	m.width, m.height = 120, 30
+	m.snapshot.Queue = laneTestItems()
+	m.queueGrouped = true
+	return m
+}
+
+func TestBuildQueueLanes_GroupsAndCounts(t *testing.T) {
+	m := laneModel()
+	lanes := buildQueueLanes(m.getSortedItems())
+
+	want := map[string][]int64{
+		"Attention": {5, 3},
+		"Running":   {2},
+		"Waiting":   {4},
+		"Done":      {1, 6},
+	}
+	if len(lanes) != len(queueLaneNames) {
+		t.Fatalf("lanes = %d, want %d", len(lanes), len(queueLaneNames))
+	}
+	for i, lane := range lanes {
+		if lane.name != queueLaneNames[i] {
+			t.Fatalf("lane %d = %q, want %q", i, lane.name, queueLaneNames[i])
+		}
+		if len(lane.items) != len(want[lane.name]) {
+			t.Fatalf("%s count = %d, want %d", lane.name, len(lane.items), len(want[lane.name]))
+		}
+		for j, item := range lane.items {
+			if item.ID != want[lane.name][j] {
+				t.Fatalf("%s[%d] = #%d, want #%d", lane.name, j, item.ID, want[lane.name][j])
+			}
+		}
+	}
+}
+
+func TestBuildQueueLanes_OmitsEmptyLanes(t *testing.T) {
+	lanes := buildQueueLanes([]spindle.QueueItem{{ID: 1, Stage: "completed"}})
+	if len(lanes) != 1 || lanes[0].name != "Done" {
+		t.Fatalf("lanes = %+v, want only Done", lanes)
+	}
+}
+
+func TestQueueRows_CollapsedLaneHidesItems(t *testing.T) {
+	m := laneModel()
+	if got := len(m.queueRows()); got != 10 { // 4 headers + 6 items
+		t.Fatalf("rows = %d, want 10", got)
+	}
+
+	m.selectedRow = 1 // first Attention item
+	m.toggleQueueLane()
+	rows := m.queueRows()
+	if len(rows) != 8 {
+		t.Fatalf("rows after collapse = %d, want 8", len(rows))
+	}
+	if rows[m.selectedRow].lane == nil || rows[m.selectedRow].lane.name != "Attention" {
+		t.Fatalf("selection should move to the collapsed lane header, got row %d", m.selectedRow)
+	}
+	if rows[0].count != 2 {
+		t.Fatalf("collapsed header count = %d, want 2", rows[0].count)
+	}
+
+	view := stripANSI(m.renderQueue())
+	if !strings.Contains(view, "▸ Attention (2)") || !strings.Contains(view, "▾ Done (2)") {
+		t.Fatalf("renderQueue() missing lane headers:\n%s", view)
+	}
+}
+
+func TestHandleQueueKey_LaneNavigation(t *testing.T) {
+	m := laneModel()
+	next := tea.KeyPressMsg{Code: ']', Text: "]"}
+	prev := tea.KeyPressMsg{Code: '[', Text: "["}
+
+	updated, _ := m.handleQueueKey(next)
+	m = updated.(Model)
+	if rows := m.queueRows(); rows[m.selectedRow].lane == nil || rows[m.selectedRow].lane.name != "Running" {
+		t.Fatalf("] should select the Running header, got row %d", m.selectedRow)
+	}
+
+	updated, _ = m.handleQueueKey(prev)
+	m = updated.(Model)
+	if m.selectedRow != 0 {
+		t.Fatalf("[ should return to the Attention header, got row %d", m.selectedRow)
+	}
+
+	// Enter on a header toggles the lane instead of opening the inspector.
+	updated, _ = m.handleQueueKey(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = updated.(Model)
+	if m.inspecting || !m.queueCollapsed["Attention"] {
+		t.Fatalf("Enter on a header should collapse it (inspecting=%v)", m.inspecting)
+	}
+}
+
+func TestToggleQueueGrouped_KeepsSelectedItem(t *testing.T) {
+	m := laneModel()
+	m.queueGrouped = false
+	m.selectedRow = 3 // flat order: 5, 3, 2, 4, 1, 6 -> #4
+	if item := m.getSelectedItem(); item == nil || item.ID != 4 {
+		t.Fatalf("flat selection = %+v, want #4", item)
+	}
+
+	m.toggleQueueGrouped()
+	if item := m.getSelectedItem(); item == nil || item.ID != 4 {
+		t.Fatalf("grouped selection = %+v, want #4", item)
+	}
+}
+
+func TestLaneColor_Resolution(t *testing.T) {
+	m := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{LaneColors: map[string]string{
+		"attention": "danger",
+		"Backfill":  "#123456",
+	}}})
+
+	cases := map[string]string{
+		"Attention": "danger",  // prefs override, case-insensitive
+		"Running":   "accent",  // theme default
+		"Done":      "muted",   // theme default
+		"backfill":  "#123456", // custom lane from prefs
+	}
+	for lane, want := range cases {
+		if got := m.laneColor(lane); got != want {
+			t.Errorf("laneColor(%q) = %q, want %q", lane, got, want)
+		}
+	}
+
+	if got := m.laneColor("overnight"); got != "muted" {
+		t.Fatalf("laneColor(unknown) = %q, want muted", got)
+	}
+
+	styles := m.theme.Styles()
+	if m.laneStyle("Attention", styles).Render("x") != styles.DangerText.Render("x") {
+		t.Fatalf("Attention override should render with the danger role")
+	}
+}
+
+func TestFollowActiveItem(t *testing.T) {
+	now := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	started := laneTestItems()
+	started[3].Tasks = []spindle.Task{{Type: "identification", State: "running"}} // #4
+
+	setup := func(p prefs.Prefs) Model {
+		m := New(Options{ThemeName: "slate", Prefs: p})
+		m.width, m.height = 120, 30
+		m.now = func() time.Time { return now }
+		m.applySnapshot(state.Snapshot{Queue: laneTestItems(), Version: 1})
+		m.selectQueueItem(6)
+		return m
+	}
+
+	m := setup(prefs.Prefs{QueueFollowIdleSeconds: 30})
+	m.applySnapshot(state.Snapshot{Queue: started, Version: 2})
+	if item := m.getSelectedItem(); item == nil || item.ID != 4 {
+		t.Fatalf("selection = %+v, want newly running #4", item)
+	}
+
+	// Navigating the queue suspends following for the grace period.
+	m = setup(prefs.Prefs{QueueFollowIdleSeconds: 30})
+	updated, _ := m.handleQueueKey(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	m = updated.(Model)
+	selected := m.getSelectedItem().ID
+	now = now.Add(10 * time.Second)
+	m.applySnapshot(state.Snapshot{Queue: started, Version: 2})
+	if item := m.getSelectedItem(); item == nil || item.ID != selected {
+		t.Fatalf("selection = %+v, want #%d kept during the grace period", item, selected)
+	}
+
+	m = setup(prefs.Prefs{})
+	m.applySnapshot(state.Snapshot{Queue: started, Version: 2})
+	if item := m.getSelectedItem(); item == nil || item.ID != 6 {
+		t.Fatalf("selection = %+v, want #6 kept with following disabled", item)
+	}
+}
+
+func TestApplySnapshot_SelectsNeighborWhenItemVanishes(t *testing.T) {
+	without := func(ids ...int64) []spindle.QueueItem {
+		return slices.DeleteFunc(laneTestItems(), func(item spindle.QueueItem) bool {
+			return slices.Contains(ids, item.ID)
+		})
+	}
+	cases := []struct {
+		name     string
+		selected int64
+		next     []spindle.QueueItem
+		want     int64
+	}{
+		// Flat order: 5, 3, 2, 4, 1, 6.
+		{"next row takes over", 2, without(2), 4},
+		{"previous row when next is gone too", 2, without(2, 4), 3},
+		{"last row falls back to previous", 6, without(6), 1},
+		{"moved item stays selected", 2, func() []spindle.QueueItem {
+			items := laneTestItems()
+			items[1].Tasks = nil // #2 stops running and sorts into Waiting
+			return items
+		}(), 2},
+	}
+	for _, tc := range cases {
+		m := laneModel()
+		m.queueGrouped = false
+		m.applySnapshot(state.Snapshot{Queue: laneTestItems(), Version: 1})
+		m.selectQueueItem(tc.selected)
+		m.applySnapshot(state.Snapshot{Queue: tc.next, Version: 2})
+		if item := m.getSelectedItem(); item == nil || item.ID != tc.want {
+			t.Errorf("%s: selection = %+v, want #%d", tc.name, item, tc.want)
+		}
+	}
+}
+
+func TestQueueRowForID_FilteredAndUnfiltered(t *testing.T) {
+	m := laneModel()
+	m.queueGrouped = false
+	if got := m.queueRowForID(4); got != 3 {
+		t.Fatalf("unfiltered row for #4 = %d, want 3", got)
+	}
+	m.filterMode = FilterFailed
+	if got := m.queueRowForID(3); got != 0 {
+		t.Fatalf("failed-filter row for #3 = %d, want 0", got)
+	}
+	if got := m.queueRowForID(4); got != -1 {
+		t.Fatalf("failed-filter row for hidden #4 = %d, want -1", got)
+	}
+	m.filterMode = FilterAll
+	m.queueFilterQuery = "#5"
+	if got := m.queueRowForID(4); got != -1 {
+		t.Fatalf("query-filtered row for #4 = %d, want -1", got)
+	}
+}
+
+func TestJumpToQueueItem_ClearsFiltersAndExpandsLane(t *testing.T) {
+	m := laneModel()
+	m.filterMode = FilterFailed
+	if !m.jumpToQueueItem(6) {
+		t.Fatal("jumpToQueueItem(6) = false, want found")
+	}
+	if m.filterMode != FilterAll {
+		t.Fatalf("filterMode = %d, want FilterAll after jumping to a hidden item", m.filterMode)
+	}
+	if item := m.getSelectedItem(); item == nil || item.ID != 6 {
+		t.Fatalf("selected = %v, want #6", item)
+	}
+
+	m.toggleQueueLane() // collapse the Done lane holding #6
+	if m.queueRowForID(1) != -1 {
+		t.Fatal("collapsed lane should hide #1")
+	}
+	if !m.jumpToQueueItem(1) {
+		t.Fatal("jumpToQueueItem(1) = false, want found")
+	}
+	if item := m.getSelectedItem(); item == nil || item.ID != 1 {
+		t.Fatalf("selected = %v, want #1 in the re-expanded lane", item)
+	}
+
+	if m.jumpToQueueItem(99) {
+		t.Fatal("jumpToQueueItem(99) = true, want not found")
+	}
+}
+
+func TestHandleQueueJumpKey_ReportsMissingItem(t *testing.T) {
+	m := laneModel()
+	for _, k := range []tea.KeyPressMsg{
+		{Code: ':', Text: ":"}, {Code: '9', Text: "9"}, {Code: '9', Text: "9"}, {Code: tea.KeyEnter},
+	} {
+		updated, _ := m.Update(k)
+		m = updated.(Model)
+	}
+	if m.queueJumpActive || m.errorMsg != "Item #99 not found" {
+		t.Fatalf("jumpActive=%v errorMsg=%q, want closed input and not-found message", m.queueJumpActive, m.errorMsg)
+	}
+
+	for _, k := range []tea.KeyPressMsg{{Code: ':', Text: ":"}, {Code: '#', Text: "#"}, {Code: '4', Text: "4"}, {Code: tea.KeyEnter}} {
+		updated, _ := m.Update(k)
+		m = updated.(Model)
+	}
+	if item := m.getSelectedItem(); item == nil || item.ID != 4 {
+		t.Fatalf("selected = %v, want #4 after \":#4\"", item)
+	}
+}
@@ -0,0 +1,423 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/five82/flyer/internal/prefs"
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
+)
+
+func sortedIDs(items []spindle.QueueItem) []int64 {
+	ids := make([]int64, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestGetSortedItems_CacheInvalidatesOnInputChange(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.snapshot.Version = 1
+	m.snapshot.Queue = []spindle.QueueItem{
+		{ID: 1, Stage: "completed", DiscTitle: "Alien"},
+		{ID: 2, Stage: "failed", DiscTitle: "Aliens"},
+		{ID: 3, Stage: "ripping", NeedsReview: true, DiscTitle: "Heat"},
+	}
+
+	first := m.getSortedItems()
+	if got := fmt.Sprint(sortedIDs(first)); got != "[3 2 1]" {
+		t.Fatalf("sorted = %s, want [3 2 1]", got)
+	}
+	if again := m.getSortedItems(); &again[0] != &first[0] {
+		t.Fatalf("unchanged inputs should reuse the cached slice")
+	}
+
+	// Value copies of the model share the cache.
+	copied := m
+	if again := copied.getSortedItems(); &again[0] != &first[0] {
+		t.Fatalf("model copy should reuse the cached slice")
+	}
+
+	m.filterMode = FilterFailed
+	if got := fmt.Sprint(sortedIDs(m.getSortedItems())); got != "[2]" {
+		t.Fatalf("filter change: sorted = %s, want [2]", got)
+	}
+
+	m.filterMode = FilterAll
+	m.queueFilterQuery = "alien"
+	if got := fmt.Sprint(sortedIDs(m.getSortedItems())); got != "[2 1]" {
+		t.Fatalf("query change: sorted = %s, want [2 1]", got)
+	}
+
+	m.queueFilterQuery = ""
+	m.snapshot.Version = 2
+	m.snapshot.Queue = []spindle.QueueItem{{ID: 4, Stage: "encoding"}}
+	if got := fmt.Sprint(sortedIDs(m.getSortedItems())); got != "[4]" {
+		t.Fatalf("new snapshot: sorted = %s, want [4]", got)
+	}
+
+	m.snapshot.Version = 3
+	m.snapshot.Queue = nil
+	if got := len(m.getSortedItems()); got != 0 {
+		t.Fatalf("empty snapshot: sorted = %d items, want 0", got)
+	}
+}
+
+func TestGetSortedItems_SortKeys(t *testing.T) {
+	running := func(pct float64) []spindle.Task {
+		return []spindle.Task{{Type: "ripping", State: "running", Progress: spindle.TaskProgress{Percent: pct}}}
+	}
+	m := New(Options{ThemeName: "slate"})
+	m.snapshot.Queue = []spindle.QueueItem{
+		{ID: 4, Stage: "completed", DiscTitle: "Brazil", UpdatedAt: "2026-05-01T10:00:00Z"},
+		{ID: 2, Stage: "failed", DiscTitle: "alien", UpdatedAt: "2026-05-01T12:00:00Z"},
+		{ID: 3, Stage: "ripping", DiscTitle: "Casino", UpdatedAt: "2026-05-01T11:00:00Z", Tasks: running(40)},
+		{ID: 1, Stage: "ripping", DiscTitle: "Brazil", UpdatedAt: "2026-05-01T11:00:00Z", Tasks: running(75)},
+	}
+
+	tests := []struct {
+		sort  QueueSort
+		desc  bool
+		want  string
+		title string
+	}{
+		{SortPriority, false, "[2 1 3 4]", "Queue (4)"},
+		{SortID, false, "[1 2 3 4]", "Queue (4) ↑ID"},
+		{SortID, true, "[4 3 2 1]", "Queue (4) ↓ID"},
+		// Ties (the two Brazils, the 11:00 updates, no progress) fall
+		// back to ascending ID in either direction.
+		{SortTitle, false, "[2 1 4 3]", "Queue (4) ↑Title"},
+		{SortUpdated, true, "[2 1 3 4]", "Queue (4) ↓Updated"},
+		{SortUpdated, false, "[4 1 3 2]", "Queue (4) ↑Updated"},
+		{SortProgress, true, "[1 3 2 4]", "Queue (4) ↓Progress"},
+	}
+	for _, tt := range tests {
+		m.queueSort, m.queueSortDesc = tt.sort, tt.desc
+		if got := fmt.Sprint(sortedIDs(m.getSortedItems())); got != tt.want {
+			t.Errorf("sort %d desc=%v: sorted = %s, want %s", tt.sort, tt.desc, got, tt.want)
+		}
+		if got := m.getQueueTitle(); got != tt.title {
+			t.Errorf("sort %d desc=%v: title = %q, want %q", tt.sort, tt.desc, got, tt.title)
+		}
+	}
+}
+
+func TestCycleSort_WrapsToPriority(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	want := []struct {
+		sort QueueSort
+		desc bool
+	}{
+		{SortID, false}, {SortTitle, false}, {SortUpdated, true}, {SortProgress, true}, {SortPriority, false},
+	}
+	for _, w := range want {
+		m.cycleSort()
+		if m.queueSort != w.sort || m.queueSortDesc != w.desc {
+			t.Fatalf("cycleSort() = (%d, %v), want (%d, %v)", m.queueSort, m.queueSortDesc, w.sort, w.desc)
+		}
+	}
+}
+
+func benchmarkQueue(n int) []spindle.QueueItem {
+	stages := []string{"completed", "failed", "encoding", "ripping", "identification"}
+	items := make([]spindle.QueueItem, n)
+	for i := range items {
+		items[i] = spindle.QueueItem{
+			ID:          int64(n - i),
+			Stage:       stages[i%len(stages)],
+			NeedsReview: i%17 == 0,
+			DiscTitle:   fmt.Sprintf("Disc %d", i),
+		}
+	}
+	return items
+}
+
+func BenchmarkGetSortedItems(b *testing.B) {
+	m := New(Options{ThemeName: "slate"})
+	m.snapshot.Version = 1
+	m.snapshot.Queue = benchmarkQueue(2000)
+
+	b.Run("cached", func(b *testing.B) {
+		for b.Loop() {
+			_ = m.getSortedItems()
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		for b.Loop() {
+			_ = m.sortItems()
+		}
+	})
+}
+
+func TestQueueLaneCell_AcrossStatuses(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	styles := m.theme.Styles()
+	running := []spindle.Task{{Type: "encoding", State: "running"}}
+	cases := []struct {
+		name  string
+		item  spindle.QueueItem
+		want  string
+		style lipgloss.Style
+	}{
+		{"review", spindle.QueueItem{ID: 1, Stage: "encoding", NeedsReview: true}, "Attention", styles.WarningText},
+		{"failed", spindle.QueueItem{ID: 2, Stage: "failed"}, "Attention", styles.DangerText},
+		{"running", spindle.QueueItem{ID: 3, Stage: "encoding", Tasks: running}, "Running", styles.AccentText},
+		{"waiting", spindle.QueueItem{ID: 4, Stage: "pending"}, "Waiting", styles.FaintText},
+		{"completed", spindle.QueueItem{ID: 5, Stage: "completed"}, "Done", styles.MutedText},
+	}
+	for _, tc := range cases {
+		got, style := m.queueLaneCell(tc.item, styles)
+		if got != tc.want {
+			t.Errorf("%s: lane = %q, want %q", tc.name, got, tc.want)
+		}
+		if style.Render("x") != tc.style.Render("x") {
+			t.Errorf("%s: lane style mismatch", tc.name)
+		}
+	}
+}
+
+func TestItemLane_EdgeCases(t *testing.T) {
+	running := []spindle.Task{{Type: "encoding", State: "running"}}
+	cases := []struct {
+		name string
+		item spindle.QueueItem
+		want string
+	}{
+		{"review beats running", spindle.QueueItem{Stage: "encoding", NeedsReview: true, Tasks: running}, "Attention"},
+		{"failed beats running", spindle.QueueItem{Stage: "FAILED", Tasks: running}, "Attention"},
+		{"completed awaiting review", spindle.QueueItem{Stage: "completed", NeedsReview: true}, "Attention"},
+		{"done tasks only", spindle.QueueItem{Stage: "encoding", Tasks: []spindle.Task{{Type: "ripping", State: "done"}}}, "Waiting"},
+		{"empty stage", spindle.QueueItem{}, "Waiting"},
+	}
+	for _, tc := range cases {
+		if got := itemLane(tc.item); got != tc.want {
+			t.Errorf("%s: lane = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestQueueItemMatches_Lane(t *testing.T) {
+	waiting := spindle.QueueItem{ID: 12, Stage: "pending", DiscTitle: "Heat"}
+	failed := spindle.QueueItem{ID: 13, Stage: "failed", DiscTitle: "Ran"}
+	if !queueItemMatches(waiting, "waiting") || queueItemMatches(failed, "waiting") {
+		t.Fatal(`"waiting" should match only the waiting item`)
+	}
+	if !queueItemMatches(failed, "attent") {
+		t.Fatal(`"attent" should match the failed item by lane`)
+	}
+	if !queueItemMatches(waiting, "heat") || !queueItemMatches(waiting, "#12") {
+		t.Fatal("title and #id matching should still work")
+	}
+}
+
+func TestRenderQueue_LaneColumnGatedOnPrefAndWidth(t *testing.T) {
+	queue := []spindle.QueueItem{{ID: 7, Stage: "failed", DiscTitle: "Heat"}}
+
+	m := New(Options{ThemeName: "slate"})
+	m.width, m.height = 120, 20
+	m.snapshot.Queue = queue
+	if out := stripANSI(m.renderQueue()); strings.Contains(out, "LANE") {
+		t.Fatalf("lane column shown without the pref:\n%s", out)
+	}
+
+	m = New(Options{ThemeName: "slate", Prefs: prefs.Prefs{QueueLaneColumn: true}})
+	m.width, m.height = 120, 20
+	m.snapshot.Queue = queue
+	out := stripANSI(m.renderQueue())
+	if !strings.Contains(out, "LANE") || !strings.Contains(out, "Attention") {
+		t.Fatalf("lane column missing with the pref:\n%s", out)
+	}
+
+	m.width = 70
+	if out := stripANSI(m.renderQueue()); strings.Contains(out, "LANE") {
+		t.Fatalf("lane column shown on a narrow terminal:\n%s", out)
+	}
+}
+
+func TestQueuePercentCell_EncodingFallsBackToFrames(t *testing.T) {
+	item := spindle.QueueItem{
+		ID:       1,
+		Stage:    "encoding",
+		Tasks:    []spindle.Task{{Type: "encoding", State: "running"}},
+		Encoding: &spindle.EncodingStatus{CurrentFrame: 300, TotalFrames: 1000},
+	}
+	if got := queuePercentCell(item); got != " 30%" {
+		t.Fatalf("queuePercentCell = %q, want \" 30%%\"", got)
+	}
+	item.Tasks[0].Progress.Percent = 55
+	if got := queuePercentCell(item); got != " 55%" {
+		t.Fatalf("task percent should win over frames, got %q", got)
+	}
+}
+
+func TestQueuePrefs_RestoredAndSaved(t *testing.T) {
+	prefsFile := filepath.Join(t.TempDir(), "prefs.toml")
+	m := New(Options{
+		ThemeName: "slate",
+		PrefsPath: prefsFile,
+		Prefs:     prefs.Prefs{QueueFilter: "review", QueueLayout: prefs.QueueLayoutLanes, LogFollowPaused: true},
+	})
+	if m.filterMode != FilterReview || !m.queueGrouped {
+		t.Fatalf("restored filter=%d grouped=%v, want review and lanes", m.filterMode, m.queueGrouped)
+	}
+	m.initLogState()
+	if m.logState.follow {
+		t.Fatal("restored log follow = true, want paused")
+	}
+
+	updated, _ := m.handleQueueKey(tea.KeyPressMsg{Code: 'f', Text: "f"})
+	m = updated.(Model)
+	updated, _ = m.handleQueueKey(tea.KeyPressMsg{Code: 'v', Text: "v"})
+	m = updated.(Model)
+	if got := prefs.Load(prefsFile); got.QueueFilter != "active" || got.QueueLayout != prefs.QueueLayoutFlat {
+		t.Fatalf("saved prefs filter=%q layout=%q, want active and flat", got.QueueFilter, got.QueueLayout)
+	}
+
+	if got := New(Options{ThemeName: "slate", Prefs: prefs.Prefs{QueueFilter: "bogus"}}); got.filterMode != FilterAll {
+		t.Fatalf("unknown filter pref = %d, want FilterAll", got.filterMode)
+	}
+}
+
+func TestTogglePin_PinnedFilter(t *testing.T) {
+	prefsFile := filepath.Join(t.TempDir(), "prefs.toml")
+	m := New(Options{ThemeName: "slate", PrefsPath: prefsFile, Prefs: prefs.Prefs{QueueFilter: "pinned"}})
+	m.width, m.height = 120, 30
+	m.snapshot.Queue = []spindle.QueueItem{{ID: 1, Stage: "encoding"}, {ID: 2, Stage: "completed"}, {ID: 3, Stage: "pending"}}
+	if m.filterMode != FilterPinned || len(m.getSortedItems()) != 0 {
+		t.Fatalf("restored filter=%d items=%v, want pinned and empty", m.filterMode, sortedIDs(m.getSortedItems()))
+	}
+
+	m.togglePin(3)
+	m.togglePin(2)
+	if got := fmt.Sprint(sortedIDs(m.getSortedItems())); got != "[3 2]" {
+		t.Fatalf("pinned filter = %s, want [3 2] (priority order)", got)
+	}
+	if got := prefs.Load(prefsFile).PinnedItems; fmt.Sprint(got) != "[3 2]" {
+		t.Fatalf("saved pins = %v, want [3 2]", got)
+	}
+
+	m.selectQueueItem(3)
+	updated, _ := m.handleQueueKey(tea.KeyPressMsg{Code: '*', Text: "*"})
+	m = updated.(Model)
+	if got := fmt.Sprint(sortedIDs(m.getSortedItems())); got != "[2]" {
+		t.Fatalf("after unpinning #3 = %s, want [2]", got)
+	}
+	if item := m.getSelectedItem(); item == nil || item.ID != 2 {
+		t.Fatalf("selection after unpin = %v, want #2", item)
+	}
+	if !strings.Contains(stripANSI(m.renderQueue()), "#2*") {
+		t.Fatal("pinned row should carry a * after its ID")
+	}
+}
+
+func TestFormatUpdated_Modes(t *testing.T) {
+	now := time.Date(2026, 5, 8, 14, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		updated  time.Time
+		relative string
+		absolute string
+	}{
+		{"fresh", now.Add(-5 * time.Minute), "5m ago", "14:25:00"},
+		{"day old", now.Add(-26 * time.Hour), "1d ago", "May 07 12:30"},
+		{"week old", now.Add(-7 * 24 * time.Hour), "7d ago", "May 01 14:30"},
+		{"zero", time.Time{}, "", ""},
+	}
+	for _, tt := range tests {
+		if got := formatUpdated(tt.updated, now, timeRelative); got != tt.relative {
+			t.Errorf("%s: relative = %q, want %q", tt.name, got, tt.relative)
+		}
+		if got := formatUpdated(tt.updated, now, timeAbsolute); got != tt.absolute {
+			t.Errorf("%s: absolute = %q, want %q", tt.name, got, tt.absolute)
+		}
+	}
+}
+
+func TestToggleTimes_SwitchesAgeColumn(t *testing.T) {
+	prefsFile := filepath.Join(t.TempDir(), "prefs.toml")
+	m := New(Options{ThemeName: "slate", PrefsPath: prefsFile})
+	m.width, m.height = 120, 30
+	now := time.Date(2026, 5, 8, 14, 30, 0, 0, time.Local)
+	m.now = func() time.Time { return now }
+	m.snapshot.Queue = []spindle.QueueItem{{ID: 1, Stage: "pending", UpdatedAt: now.Add(-5 * time.Minute).Format(time.RFC3339)}}
+
+	if out := stripANSI(m.renderQueue()); !strings.Contains(out, "AGE") || !strings.Contains(out, "5m ago") {
+		t.Fatalf("relative queue missing AGE/5m ago:\n%s", out)
+	}
+	updated, _ := m.handleQueueKey(tea.KeyPressMsg{Code: 'u', Text: "u"})
+	m = updated.(Model)
+	if out := stripANSI(m.renderQueue()); !strings.Contains(out, "UPDATED") || !strings.Contains(out, "14:25:00") {
+		t.Fatalf("absolute queue missing UPDATED/14:25:00:\n%s", out)
+	}
+	if !prefs.Load(prefsFile).QueueAbsoluteTimes {
+		t.Fatal("toggle should save queue_absolute_times")
+	}
+}
+
+func TestExportQueue_WritesJSONAndCSV(t *testing.T) {
+	dir := t.TempDir()
+	m := New(Options{ThemeName: "slate"})
+	m.now = func() time.Time { return time.Date(2026, 5, 1, 15, 4, 5, 0, time.UTC) }
+	m.snapshot.Queue = []spindle.QueueItem{
+		{ID: 1, DiscTitle: "Alien", Stage: "completed"},
+		{ID: 2, DiscTitle: "Heat", Stage: "failed"},
+	}
+
+	base := filepath.Join(dir, "flyer-queue-20260501-150405")
+	if got, want := m.exportQueue(dir), "Exported 2 items to "+base+".{json,csv}"; got != want {
+		t.Fatalf("exportQueue() = %q, want %q", got, want)
+	}
+	csvData, err := os.ReadFile(base + ".csv")
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	want := "id,title,status,lane,progress,updated\n1,Alien,completed,Done,100.0,\n2,Heat,failed,Attention,0.0,\n"
+	if string(csvData) != want {
+		t.Fatalf("csv = %q, want %q", csvData, want)
+	}
+	if _, err := os.Stat(base + ".json"); err != nil {
+		t.Fatalf("json export missing: %v", err)
+	}
+
+	if got := m.exportQueue(filepath.Join(dir, "missing")); !strings.HasPrefix(got, "Export failed: ") {
+		t.Fatalf("exportQueue(missing dir) = %q, want failure message", got)
+	}
+}
+
+func TestQueueAndOverview_FlagDuplicateDiscs(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.width, m.height = 120, 20
+	m.applySnapshot(state.Snapshot{Queue: []spindle.QueueItem{
+		{ID: 1, Stage: "completed", DiscTitle: "Heat", DiscFingerprint: "3f9a0c2e71bd8844"},
+		{ID: 2, Stage: "pending", DiscTitle: "Heat again", DiscFingerprint: "3F9A0C2E71BD8844"},
+		{ID: 3, Stage: "pending", DiscTitle: "Ran", DiscFingerprint: "aa01"},
+	}})
+
+	out := stripANSI(m.renderQueue())
+	for _, line := range strings.Split(out, "\n") {
+		switch {
+		case strings.Contains(line, "#1 ") || strings.Contains(line, "#2 "):
+			if !strings.Contains(line, "DUP") {
+				t.Errorf("duplicate row missing the DUP badge: %q", line)
+			}
+		case strings.Contains(line, "#3 "):
+			if strings.Contains(line, "DUP") {
+				t.Errorf("unique disc flagged as duplicate: %q", line)
+			}
+		}
+	}
+
+	overview := stripANSI(m.renderDetailContent(m.snapshot.Queue[1], 100))
+	if !strings.Contains(overview, "3F9A0C2E71BD · same disc as #1") {
+		t.Fatalf("overview missing the disc fingerprint and duplicate:\n%s", overview)
+	}
+}
@@ -0,0 +1,169 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
+)
+
+// noReviewReason buckets review items that carry no reason.
+const noReviewReason = "no reason given"
+
+// ReasonBucket groups the review items sharing a normalized review reason.
+type ReasonBucket struct {
+	Reason string
+	Items  []spindle.QueueItem
+}
+
+// normalizeReviewReason reduces a review reason to its bucket key:
+// lowercased, whitespace collapsed, and any detail after a colon or
+// opening parenthesis dropped, so "Low match score (0.42)" and
+// "low match score: 0.38" share a bucket.
+func normalizeReviewReason(reason string) string {
+	if i := strings.IndexAny(reason, ":("); i >= 0 {
+		reason = reason[:i]
+	}
+	return strings.ToLower(strings.Join(strings.Fields(reason), " "))
+}
+
+// itemReviewReasons returns an item's distinct normalized review reasons,
+// noReviewReason when it has none.
+func itemReviewReasons(item spindle.QueueItem) []string {
+	var reasons []string
+	for _, r := range item.ReviewReasons {
+		if r = normalizeReviewReason(r); r != "" && !slices.Contains(reasons, r) {
+			reasons = append(reasons, r)
+		}
+	}
+	if len(reasons) == 0 {
+		return []string{noReviewReason}
+	}
+	return reasons
+}
+
+// groupByReviewReason buckets the snapshot's review items by normalized
+// reason, largest bucket first (ties by reason). An item with several
+// reasons counts in each of their buckets.
+func groupByReviewReason(snapshot state.Snapshot) []ReasonBucket {
+	index := make(map[string]int)
+	var buckets []ReasonBucket
+	for _, item := range snapshot.Queue {
+		if !item.NeedsReview {
+			continue
+		}
+		for _, reason := range itemReviewReasons(item) {
+			i, ok := index[reason]
+			if !ok {
+				i = len(buckets)
+				index[reason] = i
+				buckets = append(buckets, ReasonBucket{Reason: reason})
+			}
+			buckets[i].Items = append(buckets[i].Items, item)
+		}
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if len(buckets[i].Items) != len(buckets[j].Items) {
+			return len(buckets[i].Items) > len(buckets[j].Items)
+		}
+		return buckets[i].Reason < buckets[j].Reason
+	})
+	return buckets
+}
+
+// hasReviewReason reports whether item is in the bucket for reason.
+func hasReviewReason(item spindle.QueueItem, reason string) bool {
+	return slices.Contains(itemReviewReasons(item), reason)
+}
+
+// clampReasonsRow keeps the review reasons selection within bounds.
+func (m *Model) clampReasonsRow() {
+	if count := len(groupByReviewReason(m.snapshot)); m.reasonsRow >= count {
+		m.reasonsRow = max(count-1, 0)
+	}
+}
+
+// renderReasons renders the review items grouped by reason as a Level 1
+// panel, one selectable row per bucket with its item count.
+func (m Model) renderReasons() string {
+	styles := m.theme.Styles()
+	inner := panelInnerWidth(m.width)
+	buckets := groupByReviewReason(m.snapshot)
+	visibleRows := max(m.height-4, 1)
+
+	var lines []string
+	footer := ""
+	if len(buckets) == 0 {
+		lines = append(lines, styles.MutedText.Render("No items need review"))
+	} else {
+		countWidth := len(fmt.Sprintf("%d", len(buckets[0].Items)))
+		scroll := clampQueueScroll(0, m.reasonsRow, visibleRows, len(buckets))
+		end := min(scroll+visibleRows, len(buckets))
+		for i := scroll; i < end; i++ {
+			count := fmt.Sprintf("%*d", countWidth, len(buckets[i].Items))
+			reason := truncate(buckets[i].Reason, max(inner-countWidth-2, 1))
+			if i == m.reasonsRow {
+				line := count + "  " + reason
+				if n := inner - lipgloss.Width(line); n > 0 {
+					line += strings.Repeat(" ", n)
+				}
+				lines = append(lines, styles.Selected.Render(line))
+				continue
+			}
+			lines = append(lines, styles.WarningText.Render(count)+"  "+styles.Text.Render(reason))
+		}
+		footer = scrollRangeFooter(scroll, end, len(buckets), visibleRows)
+	}
+	for len(lines) < visibleRows {
+		lines = append(lines, "")
+	}
+
+	title := fmt.Sprintf("Review Reasons (%d)", len(buckets))
+	return renderPanel(title, strings.Join(lines, "\n"), footer, m.width, styles)
+}
+
+// handleReasonsKey processes keyboard input for the review reasons view.
+// Enter filters the queue to the selected bucket's items.
+func (m Model) handleReasonsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, m.keys.Escape) {
+		m.currentView = ViewQueue
+		return m, nil
+	}
+
+	buckets := groupByReviewReason(m.snapshot)
+	if len(buckets) == 0 {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Inspect):
+		m.clampReasonsRow()
+		m.filterMode = FilterReview
+		m.reviewReason = buckets[m.reasonsRow].Reason
+		m.currentView = ViewQueue
+		m.selectedRow = 0
+		m.updateQueueTable()
+		m.prefs.QueueFilter = strings.ToLower(m.filterLabel())
+		m.savePrefs()
+	case key.Matches(msg, m.keys.Down):
+		if m.reasonsRow < len(buckets)-1 {
+			m.reasonsRow++
+		}
+	case key.Matches(msg, m.keys.Up):
+		if m.reasonsRow > 0 {
+			m.reasonsRow--
+		}
+	case key.Matches(msg, m.keys.Top):
+		m.reasonsRow = 0
+	case key.Matches(msg, m.keys.Bottom):
+		m.reasonsRow = len(buckets) - 1
+	}
+	return m, nil
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
+)
+
+func reviewQueue() []spindle.QueueItem {
+	return []spindle.QueueItem{
+		{ID: 1, NeedsReview: true, ReviewReasons: []string{"Low match score (0.42)"}},
+		{ID: 2, NeedsReview: true, ReviewReasons: []string{"Unknown disc"}},
+		{ID: 3, NeedsReview: true, ReviewReasons: []string{"low  match score: 0.38", "Unknown disc"}},
+		{ID: 4, NeedsReview: true, ReviewReasons: []string{"LOW MATCH SCORE"}},
+		{ID: 5, NeedsReview: true},
+		{ID: 6, Stage: "failed", ReviewReasons: []string{"Unknown disc"}},
+		{ID: 7, NeedsReview: true, ReviewReasons: []string{"Audio mismatch"}},
+	}
+}
+
+func TestGroupByReviewReason_CountsAndOrder(t *testing.T) {
+	buckets := groupByReviewReason(state.Snapshot{Queue: reviewQueue()})
+
+	var got []string
+	for _, b := range buckets {
+		var ids []string
+		for _, item := range b.Items {
+			ids = append(ids, fmt.Sprintf("#%d", item.ID))
+		}
+		got = append(got, fmt.Sprintf("%d %s %s", len(b.Items), b.Reason, strings.Join(ids, ",")))
+	}
+	want := []string{
+		"3 low match score #1,#3,#4",
+		"2 unknown disc #2,#3",
+		"1 audio mismatch #7",
+		"1 no reason given #5",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("groupByReviewReason() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if got := groupByReviewReason(state.Snapshot{}); len(got) != 0 {
+		t.Fatalf("groupByReviewReason(empty) = %v, want none", got)
+	}
+}
+
+func TestReasonsView_EnterFiltersQueue(t *testing.T) {
+	m := New(Options{ThemeName: "slate", PrefsPath: t.TempDir() + "/prefs.toml"})
+	m.width, m.height = 100, 30
+	m.snapshot.Queue = reviewQueue()
+
+	next, _ := m.handleKey(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	m = next.(Model)
+	if m.currentView != ViewReasons {
+		t.Fatalf("b opened view %v, want ViewReasons", m.currentView)
+	}
+	out := stripANSI(m.renderReasons())
+	if !strings.Contains(out, "Review Reasons (4)") || !strings.Contains(out, "3  low match score") {
+		t.Fatalf("renderReasons() missing buckets:\n%s", out)
+	}
+
+	next, _ = m.handleKey(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	next, _ = next.(Model).handleKey(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = next.(Model)
+	if m.currentView != ViewQueue || m.filterMode != FilterReview || m.reviewReason != "unknown disc" {
+		t.Fatalf("Enter left view %v filter %v reason %q", m.currentView, m.filterMode, m.reviewReason)
+	}
+	var ids []int64
+	for _, item := range m.getSortedItems() {
+		ids = append(ids, item.ID)
+	}
+	if fmt.Sprint(ids) != "[2 3]" {
+		t.Fatalf("filtered queue = %v, want [2 3]", ids)
+	}
+	if title := m.getQueueTitle(); !strings.Contains(title, "Review: unknown disc") {
+		t.Fatalf("queue title = %q, want the reason", title)
+	}
+
+	m.cycleFilter()
+	if m.reviewReason != "" {
+		t.Fatalf("cycling the filter kept reason %q", m.reviewReason)
+	}
+}
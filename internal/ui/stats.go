@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/five82/flyer/internal/state"
+)
+
+// renderStats renders the encode statistics rolled up across completed
+// items as a Level 1 panel.
+func (m Model) renderStats() string {
+	styles := m.theme.Styles()
+	inner := panelInnerWidth(m.width)
+	stats := state.ComputeEncodeStats(m.snapshot.Queue)
+
+	var b strings.Builder
+	if stats.Count == 0 {
+		b.WriteString(styles.MutedText.Render("No completed encodes yet"))
+	} else {
+		w := fieldWriter{b: &b, styles: styles, width: inner}
+		w.field("Items", fmt.Sprintf("%d", stats.Count), styles.Text)
+		w.field("Size", formatBytes(stats.OriginalBytes)+" -> "+formatBytes(stats.EncodedBytes)+
+			fmt.Sprintf(" (%s saved)", formatBytes(stats.OriginalBytes-stats.EncodedBytes)), styles.Text)
+		w.field("Avg", fmt.Sprintf("%.0f%% reduction", stats.AvgReduction), styles.AccentText)
+		if stats.AvgSpeed > 0 {
+			w.field("Speed", fmt.Sprintf("%.1fx avg", stats.AvgSpeed), styles.AccentText)
+		}
+	}
+
+	lines := strings.Split(strings.TrimRight(b.String(), "\n"), "\n")
+	for len(lines) < max(m.height-4, 1) {
+		lines = append(lines, "")
+	}
+	return renderPanel("Encode Stats", strings.Join(lines, "\n"), "", m.width, styles)
+}
+
+// handleStatsKey processes keyboard input for the stats view.
+func (m Model) handleStatsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, m.keys.Escape) {
+		m.currentView = ViewQueue
+	}
+	return m, nil
+}
@@ -16,6 +16,7 @@ import (
 // branches (rip-and-encode overlap, GPU work during encodes) each show
 // their own live row.
 func (m *Model) renderTaskBoard(b *strings.Builder, item spindle.QueueItem, styles Styles, width int) {
+	item = m.etas.Apply(item)
 	if len(item.Tasks) == 0 {
 		info := stageDisplay(itemDisplayStage(item))
 		glyph, style := "○", styles.MutedText
@@ -35,6 +36,9 @@ func (m *Model) renderTaskBoard(b *strings.Builder, item spindle.QueueItem, styl
 		return
 	}
 
+	if m.detailRefreshInterval() > 0 {
+		item = extrapolateProgress(item, m.lastUpdated, m.clock())
+	}
 	episodes, totals := item.EpisodeSnapshot()
 	countWidth := len(strconv.Itoa(max(totals.Planned, 1)))
 
@@ -49,6 +53,57 @@ func (m *Model) renderTaskBoard(b *strings.Builder, item spindle.QueueItem, styl
 	}
 }
 
+// taskPercent returns a task's progress percent. An encoding task that
+// reports none falls back to the encoder's own percent or frame counts.
+func taskPercent(item spindle.QueueItem, task spindle.Task) float64 {
+	if pct := task.Progress.PercentDone(); pct > 0 || task.Type != "encoding" {
+		return pct
+	}
+	return item.Encoding.OverallPercent()
+}
+
+// maxExtrapolatedPercent caps extrapolated progress: only a snapshot may
+// report a task finished.
+const maxExtrapolatedPercent = 99
+
+// extrapolateProgress advances an item's running-task progress by the time
+// since its snapshot, so the detail pane moves between polls. Encodes with
+// a reel ETA run toward it; other tasks continue at their average rate
+// since they started.
+func extrapolateProgress(item spindle.QueueItem, snapshotAt, now time.Time) spindle.QueueItem {
+	since := now.Sub(snapshotAt)
+	if snapshotAt.IsZero() || since <= 0 {
+		return item
+	}
+	tasks := make([]spindle.Task, len(item.Tasks))
+	copy(tasks, item.Tasks)
+	item.Tasks = tasks
+
+	for i := range tasks {
+		t := &tasks[i]
+		pct := taskPercent(item, *t)
+		if !t.IsRunning() || pct <= 0 || pct >= maxExtrapolatedPercent {
+			continue
+		}
+		var next float64
+		if eta := item.Encoding.ETADuration(); t.Type == "encoding" && eta > 0 {
+			next = pct + (100-pct)*min(float64(since)/float64(eta), 1)
+		} else if started := t.ParsedStartedAt(); !started.IsZero() && snapshotAt.After(started) {
+			next = pct + pct*float64(since)/float64(snapshotAt.Sub(started))
+		} else {
+			continue
+		}
+		t.Progress.Percent = min(next, maxExtrapolatedPercent)
+	}
+
+	if enc := item.Encoding; enc != nil && enc.ETASeconds > 0 {
+		advanced := *enc
+		advanced.ETASeconds = max(enc.ETASeconds-since.Seconds(), 0)
+		item.Encoding = &advanced
+	}
+	return item
+}
+
 // itemElapsed reports the item's wall-clock time in the pipeline: creation
 // to the last task finish for terminal items, creation to now otherwise.
 // Empty under a minute -- the figure means nothing that fresh.
@@ -57,20 +112,20 @@ func itemElapsed(item spindle.QueueItem) string {
 	if created.IsZero() {
 		return ""
 	}
-	end := time.Now()
+	d := item.Elapsed()
 	if item.IsTerminal() {
-		end = item.ParsedUpdatedAt()
+		end := item.ParsedUpdatedAt()
 		for _, t := range item.Tasks {
 			if fin := t.ParsedFinishedAt(); fin.After(end) {
 				end = fin
 			}
 		}
+		d = end.Sub(created)
 	}
-	d := end.Sub(created)
 	if d < time.Minute {
 		return ""
 	}
-	return humanizeDurationLong(d)
+	return humanizeSpan(d, time.Minute, 2)
 }
 
 func (m *Model) renderTaskRow(b *strings.Builder, item spindle.QueueItem, task spindle.Task, episodes []spindle.EpisodeStatus, totals spindle.EpisodeTotals, countWidth int, styles Styles, width int) {
@@ -109,18 +164,19 @@ func (m *Model) renderTaskRow(b *strings.Builder, item spindle.QueueItem, task s
 
 	switch task.State {
 	case "running":
+		percent := taskPercent(item, task)
 		b.WriteString("  ")
-		b.WriteString(renderProgressBar(task.Progress.Percent, 20, roleStyle(info.role, styles), styles))
+		b.WriteString(renderProgressBar(percent, 20, roleStyle(info.role, styles), styles))
 		b.WriteString(" ")
-		b.WriteString(styles.Text.Render(fmt.Sprintf("%3.0f%%", clampPercent(task.Progress.Percent))))
-		for _, extra := range taskExtras(item, task, totals) {
+		b.WriteString(styles.Text.Render(fmt.Sprintf("%3.0f%%", clampPercent(percent))))
+		for _, extra := range m.taskExtras(item, task, totals) {
 			b.WriteString("  ")
 			b.WriteString(styles.MutedText.Render(extra))
 		}
 	case "done":
 		if d := task.Duration(); d > 0 {
 			b.WriteString("  ")
-			b.WriteString(styles.FaintText.Render(formatDuration(d)))
+			b.WriteString(styles.FaintText.Render(humanizeSpan(d, time.Second, 2)))
 		}
 	}
 
@@ -213,7 +269,7 @@ func runningTaskMessage(task spindle.Task) string {
 
 // taskExtras returns supplemental figures for a running task's row:
 // fps and substage for encodes, byte progress for copy-style tasks, an ETA.
-func taskExtras(item spindle.QueueItem, task spindle.Task, totals spindle.EpisodeTotals) []string {
+func (m Model) taskExtras(item spindle.QueueItem, task spindle.Task, totals spindle.EpisodeTotals) []string {
 	var extras []string
 	if task.Type == "encoding" && item.Encoding != nil {
 		if sub := strings.TrimSpace(item.Encoding.Substage); sub != "" {
@@ -223,41 +279,75 @@ func taskExtras(item spindle.QueueItem, task spindle.Task, totals spindle.Episod
 			extras = append(extras, fmt.Sprintf("%.0f fps", item.Encoding.FPS))
 		}
 	}
-	if task.Progress.TotalBytes > 0 {
-		extras = append(extras, fmt.Sprintf("%s / %s",
-			formatBytes(task.Progress.BytesCopied),
-			formatBytes(task.Progress.TotalBytes)))
+	if bytes := taskByteProgress(task.Progress); bytes != "" {
+		extras = append(extras, bytes)
 	}
-	if eta := taskETA(item, task, totals); eta != "" {
-		extras = append(extras, eta)
+	if eta := taskRemaining(item, task, totals, m.clock()); eta > 0 {
+		extras = append(extras, m.formatETA(eta))
 	}
 	return extras
 }
 
-// taskETA estimates remaining time for a running task. Single-file encodes
-// use reel's own ETA; everything else derives from the task's server-side
-// start time and percent (no client-side stage tracking needed).
-func taskETA(item spindle.QueueItem, task spindle.Task, totals spindle.EpisodeTotals) string {
+// taskByteProgress renders copy-style byte progress as "X / Y (Z%)",
+// blank when the task reports no byte total. The percent is byte-derived so
+// it stays truthful when the task's own percent tracks something else.
+func taskByteProgress(p spindle.TaskProgress) string {
+	if p.TotalBytes <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s / %s (%.0f%%)", formatBytes(p.BytesCopied), formatBytes(p.TotalBytes), p.BytePercent())
+}
+
+// taskRemaining estimates remaining time for a running task, zero when
+// unknown. Single-file encodes use reel's own ETA; everything else derives
+// from the task's server-side start time and percent (no client-side stage
+// tracking needed).
+func taskRemaining(item spindle.QueueItem, task spindle.Task, totals spindle.EpisodeTotals, now time.Time) time.Duration {
 	if task.Type == "encoding" && totals.Planned <= 1 && item.Encoding != nil {
 		if eta := item.Encoding.ETADuration(); eta > 0 {
-			return "ETA " + formatDuration(eta)
+			return eta
 		}
 	}
-	percent := clampPercent(task.Progress.Percent)
+	percent := clampPercent(taskPercent(item, task))
 	if percent < 5 || percent >= 100 {
-		return ""
+		return 0
 	}
 	started := task.ParsedStartedAt()
 	if started.IsZero() {
-		return ""
+		return 0
 	}
-	elapsed := time.Since(started)
+	elapsed := now.Sub(started)
 	if elapsed <= 0 {
-		return ""
+		return 0
 	}
-	remaining := time.Duration(float64(elapsed) * (100 - percent) / percent)
-	if remaining <= 0 {
-		return ""
+	return max(time.Duration(float64(elapsed)*(100-percent)/percent), 0)
+}
+
+// formatETA renders a remaining duration as "ETA 1h 20m", adding the
+// estimated completion clock time when the eta_clock_time pref is on.
+func (m Model) formatETA(eta time.Duration) string {
+	label := "ETA " + humanizeSpan(eta, time.Second, 2)
+	if m.prefs.ETAClockTime {
+		label += " (" + formatETAClock(m.clock(), eta) + ")"
+	}
+	return label
+}
+
+// formatETAClock renders now+eta as "done ~15:42", naming the weekday when
+// completion falls on a later day and the date beyond a week out.
+func formatETAClock(now time.Time, eta time.Duration) string {
+	done := now.Add(eta).Round(time.Minute)
+	nowY, nowM, nowD := now.Date()
+	doneY, doneM, doneD := done.Date()
+	today := time.Date(nowY, nowM, nowD, 0, 0, 0, 0, now.Location())
+	// Round to whole days so DST shifts don't turn tomorrow into today.
+	days := (time.Date(doneY, doneM, doneD, 0, 0, 0, 0, done.Location()).Sub(today) + 12*time.Hour) / (24 * time.Hour)
+	switch {
+	case days <= 0:
+		return "done ~" + done.Format("15:04")
+	case days < 7:
+		return "done ~" + done.Format("Mon 15:04")
+	default:
+		return "done ~" + done.Format("Jan 02 15:04")
 	}
-	return "ETA " + formatDuration(remaining)
 }
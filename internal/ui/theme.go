@@ -34,10 +34,32 @@ type Theme struct {
 	Warning string
 	Danger  string
 	Info    string
+
+	// Monochrome themes carry emphasis in text attributes rather than hue:
+	// bold and underline for status roles, reverse video for the selection
+	// bar and chips, so they survive terminals that render no color.
+	Monochrome bool
+
+	// LaneColors maps lowercase lane names to a theme role (accent, info,
+	// warning, success, danger, muted, faint) or a literal color. Lanes
+	// missing here get a stable color from laneFallbackRoles.
+	LaneColors map[string]string
 }
 
 // Styles returns Lipgloss styles for this theme.
 func (t Theme) Styles() Styles {
+	s := t.colorStyles()
+	if t.Monochrome {
+		s.AccentText = s.AccentText.Bold(true)
+		s.WarningText = s.WarningText.Underline(true)
+		s.DangerText = s.DangerText.Underline(true)
+		s.Selected = lipgloss.NewStyle().Reverse(true)
+	}
+	return s
+}
+
+// colorStyles returns the theme's styles before any monochrome emphasis.
+func (t Theme) colorStyles() Styles {
 	return Styles{
 		Text: lipgloss.NewStyle().
 			Foreground(lipgloss.Color(t.Text)),
@@ -112,31 +134,56 @@ type Styles struct {
 	Band        lipgloss.Style
 }
 
+// defaultLaneColors is the lane color map shared by the built-in themes.
+func defaultLaneColors() map[string]string {
+	return map[string]string{
+		"attention": "warning",
+		"running":   "accent",
+		"waiting":   "faint",
+		"done":      "muted",
+	}
+}
+
 // Theme definitions
 
 var themes = map[string]Theme{
+	"Mono":     monoTheme(),
 	"Nightfox": nightfoxTheme(),
 	"Slate":    slateTheme(),
 }
 
-var themeOrder = []string{"Slate", "Nightfox"}
+var themeOrder = []string{"Slate", "Nightfox", "Mono"}
+
+// monoThemeName is the theme chosen when the terminal shows no color.
+const monoThemeName = "Mono"
 
-// GetTheme returns a theme by name.
-func GetTheme(name string) Theme {
+// GetTheme returns a theme by name, looking through the built-in themes and
+// then custom (file-defined) ones.
+func GetTheme(name string, custom ...Theme) Theme {
 	if t, ok := themes[name]; ok {
 		return t
 	}
+	for _, t := range custom {
+		if t.Name == name {
+			return t
+		}
+	}
 	return slateTheme()
 }
 
-// NextTheme returns the next theme name in the cycle.
-func NextTheme(current string) string {
-	for i, name := range themeOrder {
+// NextTheme returns the next theme name in the cycle: the built-in themes,
+// then custom ones in order.
+func NextTheme(current string, custom ...Theme) string {
+	order := themeOrder
+	for _, t := range custom {
+		order = append(order[:len(order):len(order)], t.Name)
+	}
+	for i, name := range order {
 		if name == current {
-			return themeOrder[(i+1)%len(themeOrder)]
+			return order[(i+1)%len(order)]
 		}
 	}
-	return themeOrder[0]
+	return order[0]
 }
 
 func nightfoxTheme() Theme {
@@ -160,6 +207,8 @@ func nightfoxTheme() Theme {
 		Warning: "#dbc074", // yellow
 		Danger:  "#c94f6d", // red
 		Info:    "#63cdcf", // cyan
+
+		LaneColors: defaultLaneColors(),
 	}
 }
 
@@ -184,5 +233,36 @@ func slateTheme() Theme {
 		Warning: "#f59e0b", // amber-500
 		Danger:  "#ef4444", // red-500
 		Info:    "#06b6d4", // cyan-500
+
+		LaneColors: defaultLaneColors(),
+	}
+}
+
+func monoTheme() Theme {
+	// Grays only; status differences come from labels, glyphs, and the
+	// bold/underline/reverse attributes set in Styles.
+	return Theme{
+		Name: monoThemeName,
+
+		Background: "#000000",
+		Surface:    "#262626",
+
+		SelectionBg:   "#d0d0d0",
+		SelectionText: "#000000",
+
+		Border: "#4e4e4e",
+
+		Text:    "#d0d0d0",
+		Muted:   "#949494",
+		Faint:   "#6c6c6c",
+		Accent:  "#eeeeee",
+		Success: "#d0d0d0",
+		Warning: "#eeeeee",
+		Danger:  "#ffffff",
+		Info:    "#bcbcbc",
+
+		Monochrome: true,
+
+		LaneColors: defaultLaneColors(),
 	}
 }
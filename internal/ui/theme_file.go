@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// themeFile is the on-disk form of a custom theme. Every color is optional;
+// missing ones come from the Base built-in theme (Slate when unset).
+type themeFile struct {
+	Name string `toml:"name"`
+	Base string `toml:"base"`
+
+	Background    string `toml:"background"`
+	Surface       string `toml:"surface"`
+	SelectionBg   string `toml:"selection_bg"`
+	SelectionText string `toml:"selection_text"`
+	Border        string `toml:"border"`
+
+	Text    string `toml:"text"`
+	Muted   string `toml:"muted"`
+	Faint   string `toml:"faint"`
+	Accent  string `toml:"accent"`
+	Success string `toml:"success"`
+	Warning string `toml:"warning"`
+	Danger  string `toml:"danger"`
+	Info    string `toml:"info"`
+
+	LaneColors map[string]string `toml:"lane_colors"`
+}
+
+// LoadThemeFile reads a custom theme from a TOML file. The theme is named
+// by its name key, or the file name without extension. Colors must be hex
+// (#rgb or #rrggbb); lane colors may also be a theme role.
+func LoadThemeFile(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, err
+	}
+	var f themeFile
+	if err := toml.Unmarshal(data, &f); err != nil {
+		return Theme{}, fmt.Errorf("parse %s: %w", filepath.Base(path), err)
+	}
+
+	name := strings.TrimSpace(f.Name)
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	base := slateTheme()
+	if f.Base != "" {
+		b, ok := themes[f.Base]
+		if !ok {
+			return Theme{}, fmt.Errorf("theme %s: unknown base %q", name, f.Base)
+		}
+		base = b
+	}
+
+	t := base
+	t.Name = name
+	for _, c := range []struct {
+		key   string
+		value string
+		dst   *string
+	}{
+		{"background", f.Background, &t.Background},
+		{"surface", f.Surface, &t.Surface},
+		{"selection_bg", f.SelectionBg, &t.SelectionBg},
+		{"selection_text", f.SelectionText, &t.SelectionText},
+		{"border", f.Border, &t.Border},
+		{"text", f.Text, &t.Text},
+		{"muted", f.Muted, &t.Muted},
+		{"faint", f.Faint, &t.Faint},
+		{"accent", f.Accent, &t.Accent},
+		{"success", f.Success, &t.Success},
+		{"warning", f.Warning, &t.Warning},
+		{"danger", f.Danger, &t.Danger},
+		{"info", f.Info, &t.Info},
+	} {
+		value := strings.TrimSpace(c.value)
+		if value == "" {
+			continue
+		}
+		if !isHexColor(value) {
+			return Theme{}, fmt.Errorf("theme %s: %s %q is not a hex color", name, c.key, value)
+		}
+		*c.dst = value
+	}
+
+	t.LaneColors = make(map[string]string, len(base.LaneColors)+len(f.LaneColors))
+	for lane, color := range base.LaneColors {
+		t.LaneColors[lane] = color
+	}
+	for lane, color := range f.LaneColors {
+		color = strings.TrimSpace(color)
+		if !isHexColor(color) && !isThemeRole(color) {
+			return Theme{}, fmt.Errorf("theme %s: lane %s color %q is not a role or hex color", name, lane, color)
+		}
+		t.LaneColors[strings.ToLower(lane)] = color
+	}
+	return t, nil
+}
+
+// LoadThemeDir loads every *.toml theme in dir, sorted by file name. Files
+// that fail to load, or whose name repeats a built-in or earlier theme, are
+// skipped with a warning. A missing dir yields no themes.
+func LoadThemeDir(dir string) ([]Theme, []string) {
+	if dir == "" {
+		return nil, nil
+	}
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.toml"))
+	sort.Strings(paths)
+
+	var loaded []Theme
+	var warnings []string
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		t, err := LoadThemeFile(path)
+		if err != nil {
+			warnings = append(warnings, err.Error())
+			continue
+		}
+		if _, builtin := themes[t.Name]; builtin || seen[t.Name] {
+			warnings = append(warnings, fmt.Sprintf("theme %s: duplicate name in %s", t.Name, filepath.Base(path)))
+			continue
+		}
+		seen[t.Name] = true
+		loaded = append(loaded, t)
+	}
+	return loaded, warnings
+}
+
+// isHexColor reports whether s is a #rgb or #rrggbb color.
+func isHexColor(s string) bool {
+	if len(s) != 4 && len(s) != 7 || s[0] != '#' {
+		return false
+	}
+	for _, r := range s[1:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isThemeRole reports whether s names a theme color role.
+func isThemeRole(s string) bool {
+	switch strings.ToLower(s) {
+	case "accent", "info", "warning", "success", "danger", "muted", "faint":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeThemeFile(t *testing.T, dir, name, body string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("write theme: %v", err)
+	}
+	return path
+}
+
+func TestLoadThemeFile_PartialFallsBackToBase(t *testing.T) {
+	path := writeThemeFile(t, t.TempDir(), "dracula.toml", `
+base = "Nightfox"
+background = "#282a36"
+accent = "#BD93F9"
+danger = "#f55"
+
+[lane_colors]
+Attention = "danger"
+`)
+	got, err := LoadThemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadThemeFile: %v", err)
+	}
+	base := nightfoxTheme()
+	if got.Name != "dracula" {
+		t.Errorf("Name = %q, want file name", got.Name)
+	}
+	if got.Background != "#282a36" || got.Accent != "#BD93F9" || got.Danger != "#f55" {
+		t.Errorf("file colors not applied: %+v", got)
+	}
+	if got.Text != base.Text || got.Surface != base.Surface {
+		t.Errorf("missing keys = %q/%q, want base %q/%q", got.Text, got.Surface, base.Text, base.Surface)
+	}
+	if got.LaneColors["attention"] != "danger" || got.LaneColors["running"] != base.LaneColors["running"] {
+		t.Errorf("LaneColors = %v", got.LaneColors)
+	}
+	if nightfoxTheme().LaneColors["attention"] != "warning" {
+		t.Errorf("loading a theme mutated its base")
+	}
+}
+
+func TestLoadThemeFile_RejectsInvalidColors(t *testing.T) {
+	dir := t.TempDir()
+	for _, tc := range []struct{ name, body, want string }{
+		{"named.toml", `accent = "blue"`, "accent"},
+		{"short.toml", `text = "#12345"`, "text"},
+		{"nothex.toml", `surface = "#zzzzzz"`, "surface"},
+		{"lane.toml", "[lane_colors]\nrunning = \"teal\"", "lane running"},
+		{"base.toml", `base = "Dracula"`, "unknown base"},
+	} {
+		_, err := LoadThemeFile(writeThemeFile(t, dir, tc.name, tc.body))
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: err = %v, want mention of %q", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestLoadThemeDir_AddsThemesToCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeThemeFile(t, dir, "a.toml", `name = "Dracula"`)
+	writeThemeFile(t, dir, "b.toml", `name = "Slate"`)
+	writeThemeFile(t, dir, "c.toml", `accent = "nope"`)
+	writeThemeFile(t, dir, "notes.txt", `name = "Ignored"`)
+
+	custom, warnings := LoadThemeDir(dir)
+	if len(custom) != 1 || custom[0].Name != "Dracula" {
+		t.Fatalf("LoadThemeDir themes = %+v, want only Dracula", custom)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("LoadThemeDir warnings = %q, want duplicate and invalid", warnings)
+	}
+
+	if got := NextTheme("Mono", custom...); got != "Dracula" {
+		t.Errorf("NextTheme(Mono) = %q, want Dracula", got)
+	}
+	if got := NextTheme("Dracula", custom...); got != "Slate" {
+		t.Errorf("NextTheme(Dracula) = %q, want Slate", got)
+	}
+	if got := GetTheme("Dracula", custom...); got.Name != "Dracula" {
+		t.Errorf("GetTheme(Dracula) = %q", got.Name)
+	}
+	if got := NextTheme("Mono"); got != "Slate" {
+		t.Errorf("NextTheme(Mono) without custom = %q, want Slate", got)
+	}
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/colorprofile"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+func TestNew_NoColorSelectsMono(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	m := New(Options{ThemeName: "Nightfox", PrefsPath: t.TempDir() + "/prefs.toml"})
+	if m.theme.Name != monoThemeName {
+		t.Fatalf("theme = %q with NO_COLOR set, want %q", m.theme.Name, monoThemeName)
+	}
+
+	t.Setenv("NO_COLOR", "")
+	m = New(Options{ThemeName: "Nightfox", PrefsPath: t.TempDir() + "/prefs.toml"})
+	if m.theme.Name != "Nightfox" {
+		t.Fatalf("theme = %q with NO_COLOR empty, want Nightfox", m.theme.Name)
+	}
+}
+
+func TestUpdate_ColorlessProfileSelectsMono(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	m := New(Options{ThemeName: "Slate", PrefsPath: t.TempDir() + "/prefs.toml"})
+
+	next, _ := m.Update(tea.ColorProfileMsg{Profile: colorprofile.TrueColor})
+	if got := next.(Model).theme.Name; got != "Slate" {
+		t.Fatalf("theme = %q on a TrueColor terminal, want Slate", got)
+	}
+	next, _ = m.Update(tea.ColorProfileMsg{Profile: colorprofile.ASCII})
+	got := next.(Model)
+	if got.theme.Name != monoThemeName {
+		t.Fatalf("theme = %q on an ASCII terminal, want %q", got.theme.Name, monoThemeName)
+	}
+	if got.prefs.Theme == monoThemeName {
+		t.Fatalf("auto-selected theme should not be saved as the preference")
+	}
+}
+
+func TestMonoTheme_StatusLabelsDistinct(t *testing.T) {
+	styles := GetTheme(monoThemeName).Styles()
+	items := []spindle.QueueItem{
+		{ID: 1, Stage: "failed"},
+		{ID: 2, Stage: "encoding", NeedsReview: true},
+		{ID: 3, Stage: "completed"},
+		{ID: 4, Stage: "encoding"},
+	}
+	seen := make(map[string]int64)
+	for _, item := range items {
+		label, _ := queueStageCell(item, styles)
+		if prev, ok := seen[label]; ok {
+			t.Fatalf("items #%d and #%d share stage label %q", prev, item.ID, label)
+		}
+		seen[label] = item.ID
+	}
+
+	if !styles.DangerText.GetBold() || !styles.DangerText.GetUnderline() {
+		t.Errorf("mono danger text should be bold and underlined")
+	}
+	if !styles.WarningText.GetUnderline() {
+		t.Errorf("mono warning text should be underlined")
+	}
+	if !styles.Selected.GetReverse() {
+		t.Errorf("mono selection should use reverse video")
+	}
+}
@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/five82/flyer/internal/spindle"
+)
+
+// watchLogLines caps the recent log lines the watch view shows.
+const watchLogLines = 8
+
+// watchSelected opens the watch view on the selected queue item. The view
+// follows the item by ID, so it stays put as the queue reorders.
+func (m Model) watchSelected() (tea.Model, tea.Cmd) {
+	item := m.getSelectedItem()
+	if item == nil {
+		return m, nil
+	}
+	m.watchID = item.ID
+	m.currentView = ViewWatch
+	m.useItemLogs()
+	m.logState.lastRefresh = time.Time{}
+	return m, m.refreshLogs(item)
+}
+
+// getWatchedItem returns the watched item from the current snapshot, nil
+// once it has left the queue.
+func (m *Model) getWatchedItem() *spindle.QueueItem {
+	for i := range m.snapshot.Queue {
+		if m.snapshot.Queue[i].ID == m.watchID {
+			return &m.snapshot.Queue[i]
+		}
+	}
+	return nil
+}
+
+// renderWatch renders the watch view: one item's stage, a full-width
+// progress bar, ETA and encode rate, and its latest log lines.
+func (m Model) renderWatch() string {
+	styles := m.theme.Styles()
+	inner := panelInnerWidth(m.width)
+
+	var b strings.Builder
+	item := m.getWatchedItem()
+	if item == nil {
+		b.WriteString(styles.MutedText.Render(fmt.Sprintf("Item #%d is no longer in the queue", m.watchID)))
+	} else {
+		m.renderWatchItem(&b, m.etas.Apply(*item), inner, styles)
+	}
+
+	lines := strings.Split(strings.TrimRight(b.String(), "\n"), "\n")
+	for len(lines) < max(m.height-4, 1) {
+		lines = append(lines, "")
+	}
+	return renderPanel(fmt.Sprintf("Watching #%d", m.watchID), strings.Join(lines, "\n"), "", m.width, styles)
+}
+
+// renderWatchItem writes the watched item's dashboard body.
+func (m Model) renderWatchItem(b *strings.Builder, item spindle.QueueItem, width int, styles Styles) {
+	info := stageDisplay(itemDisplayStage(item))
+	label := info.label
+	if item.IsTerminal() {
+		label = info.doneLabel
+	}
+	stageStyle := roleStyle(info.role, styles)
+	b.WriteString(styles.Text.Bold(true).Render(truncate(item.FullTitle(), width)))
+	b.WriteString("\n")
+	b.WriteString(stageStyle.Bold(true).Render(strings.ToUpper(label)))
+	b.WriteString(styles.MutedText.Render(" · " + itemLane(item)))
+	b.WriteString("\n\n")
+
+	var pct float64
+	task := item.PrimaryTask()
+	switch {
+	case strings.EqualFold(item.Stage, "completed"):
+		pct = 100
+	case task != nil && task.IsRunning():
+		pct = clampPercent(taskPercent(item, *task))
+	}
+	b.WriteString(renderProgressBar(pct, max(width-5, 1), stageStyle, styles))
+	b.WriteString(styles.Text.Render(fmt.Sprintf(" %3.0f%%", pct)))
+	b.WriteString("\n\n")
+
+	w := fieldWriter{b: b, styles: styles, width: width}
+	_, totals := item.EpisodeSnapshot()
+	if task != nil && task.IsRunning() {
+		if eta := taskRemaining(item, *task, totals, m.clock()); eta > 0 {
+			w.field("ETA", strings.TrimPrefix(m.formatETA(eta), "ETA "), styles.AccentText)
+		}
+	}
+	if enc := item.Encoding; enc != nil && enc.FPS > 0 {
+		w.field("Rate", fmt.Sprintf("%.1f fps", enc.FPS), styles.Text)
+	}
+	if totals.Planned > 1 {
+		w.field("Episodes", fmt.Sprintf("%d/%d final", totals.Final, totals.Planned), styles.Text)
+	}
+
+	events := m.logState.rawLines
+	if m.logState.lastItemID != item.ID {
+		events = nil
+	}
+	b.WriteString("\n")
+	b.WriteString(styles.FaintText.Render("Recent log"))
+	b.WriteString("\n")
+	if len(events) == 0 {
+		b.WriteString(styles.MutedText.Render("No log lines yet"))
+		b.WriteString("\n")
+		return
+	}
+	for _, evt := range events[max(len(events)-watchLogLines, 0):] {
+		style := styles.MutedText
+		switch level := normalizeLogLevel(evt.Level); level {
+		case "WARN", "ERROR", "FATAL":
+			style = m.getLevelStyle(level, styles)
+		}
+		b.WriteString(style.Render(truncate(formatLogEvent(evt), width)))
+		b.WriteString("\n")
+	}
+}
+
+// handleWatchKey processes keyboard input for the watch view. Leaving
+// selects the watched item in the queue.
+func (m Model) handleWatchKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Escape):
+		m.currentView = ViewQueue
+		m.selectQueueItem(m.watchID)
+		m.ensureQueueVisible()
+	case key.Matches(msg, m.keys.Inspect):
+		m.currentView = ViewQueue
+		m.selectQueueItem(m.watchID)
+		m.ensureQueueVisible()
+		return m.openInspector(tabOverview)
+	}
+	return m, nil
+}
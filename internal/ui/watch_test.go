@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/five82/flyer/internal/spindle"
+	"github.com/five82/flyer/internal/state"
+)
+
+func TestWatch_FollowsItemByIDAcrossSnapshots(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.initLogState()
+	m.width, m.height = 100, 30
+	m.applySnapshot(state.Snapshot{Version: 1, Queue: []spindle.QueueItem{
+		{ID: 1, Stage: "pending", DiscTitle: "First"},
+		{ID: 2, Stage: "encoding", DiscTitle: "Second", Tasks: []spindle.Task{{Type: "encoding", State: "running", Progress: spindle.TaskProgress{Percent: 30}}}},
+	}})
+	m.selectQueueItem(2)
+
+	updated, _ := m.handleQueueKey(tea.KeyPressMsg{Code: 'w', Text: "w"})
+	m = updated.(Model)
+	if m.currentView != ViewWatch || m.watchID != 2 {
+		t.Fatalf("view=%d watchID=%d, want watch view on #2", m.currentView, m.watchID)
+	}
+
+	// The queue reorders and the item advances: the view stays on #2.
+	m.applySnapshot(state.Snapshot{Version: 2, Queue: []spindle.QueueItem{
+		{ID: 3, Stage: "pending", DiscTitle: "Third"},
+		{ID: 2, Stage: "encoding", DiscTitle: "Second", Tasks: []spindle.Task{{Type: "encoding", State: "running", Progress: spindle.TaskProgress{Percent: 64}}}},
+		{ID: 1, Stage: "pending", DiscTitle: "First"},
+	}})
+	if item := m.getWatchedItem(); item == nil || item.ID != 2 {
+		t.Fatalf("getWatchedItem() = %v, want #2", item)
+	}
+	out := stripANSI(m.renderWatch())
+	for _, want := range []string{"Watching #2", "Second", "ENCODING", "64%"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("renderWatch() missing %q:\n%s", want, out)
+		}
+	}
+
+	m.applySnapshot(state.Snapshot{Version: 3, Queue: []spindle.QueueItem{{ID: 1, Stage: "pending"}}})
+	if m.getWatchedItem() != nil || !strings.Contains(stripANSI(m.renderWatch()), "Item #2 is no longer in the queue") {
+		t.Fatal("a removed item should render as gone")
+	}
+}
+
+func TestWatch_EscSelectsWatchedItem(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.initLogState()
+	m.width, m.height = 100, 30
+	m.snapshot.Queue = []spindle.QueueItem{{ID: 1, Stage: "pending"}, {ID: 2, Stage: "pending"}, {ID: 3, Stage: "pending"}}
+	m.selectQueueItem(3)
+	updated, _ := m.watchSelected()
+	m = updated.(Model)
+	m.selectQueueItem(1)
+
+	updated, _ = m.handleWatchKey(tea.KeyPressMsg{Code: tea.KeyEscape})
+	m = updated.(Model)
+	if m.currentView != ViewQueue {
+		t.Fatalf("view = %d, want queue", m.currentView)
+	}
+	if item := m.getSelectedItem(); item == nil || item.ID != 3 {
+		t.Fatalf("selection after Esc = %v, want #3", item)
+	}
+}
+
+func TestWatch_ShowsRecentLogLines(t *testing.T) {
+	m := New(Options{ThemeName: "slate"})
+	m.initLogState()
+	m.width, m.height = 100, 30
+	m.snapshot.Queue = []spindle.QueueItem{{ID: 5, Stage: "encoding"}}
+	m.watchID = 5
+	m.logState.mode = logSourceItem
+	m.logState.lastItemID = 5
+	for i := range 12 {
+		m.logState.rawLines = append(m.logState.rawLines, spindle.LogEvent{Sequence: uint64(i + 1), Level: "info", Message: "line " + string(rune('a'+i))})
+	}
+
+	out := stripANSI(m.renderWatch())
+	if strings.Contains(out, "line d") || !strings.Contains(out, "line e") || !strings.Contains(out, "line l") {
+		t.Fatalf("renderWatch() should show the last %d lines:\n%s", watchLogLines, out)
+	}
+}